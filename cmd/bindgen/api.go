@@ -0,0 +1,173 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+// API describes the C functions of one library and how they are bound in one Go package.
+type API struct {
+	// Package is the name of the Go package, e.g. "sdl".
+	Package string `json:"package"`
+	// Library is the name of the C library, e.g. "SDL3".
+	Library string `json:"library"`
+	// Prefix is the prefix of the C function names, e.g. "SDL_".
+	Prefix string `json:"prefix"`
+	// VarPrefix is the prefix of the function variables, e.g. "sdl".
+	VarPrefix string `json:"varPrefix"`
+	// Wiki is the base URL of the documentation of the C functions.
+	Wiki string `json:"wiki"`
+	// MinimumVersion is the library version all functions without Since are available in.
+	MinimumVersion string `json:"minimumVersion"`
+	// Include is the header to include for measuring the structs, e.g. "SDL3/SDL.h".
+	Include string `json:"include"`
+	// Functions lists all functions of the library's headers.
+	Functions []*Function `json:"functions"`
+	// PointerSize is the size of pointers on the platform the structs were measured on.
+	PointerSize uintptr `json:"pointerSize,omitempty"`
+	// Structs lists the C structs mirrored by Go types, with their layout as measured from the headers.
+	Structs []*Struct `json:"structs,omitempty"`
+}
+
+// Function describes a single C function.
+type Function struct {
+	// Name is the C name, e.g. "SDL_CreateWindow".
+	Name string `json:"name"`
+	// Var is the name of the Go function variable, e.g. "sdlCreateWindow".
+	Var string `json:"var"`
+	// Type is the Go type of the variable. "uintptr" binds the function pointer for use with purego.SyscallN.
+	Type string `json:"type"`
+	// Since is the library version introducing the function, if newer than the API's MinimumVersion.
+	Since string `json:"since,omitempty"`
+	// Bind reports whether the function is registered. Unbound functions are listed as comments.
+	Bind bool `json:"bind,omitempty"`
+	// Wrapper describes a generated exported wrapper. Most wrappers are written by hand instead.
+	Wrapper *Wrapper `json:"wrapper,omitempty"`
+}
+
+// Wrapper describes an exported Go function calling the function variable with unchanged arguments.
+type Wrapper struct {
+	// Name is the Go name, e.g. "WasInit".
+	Name string `json:"name"`
+	// Params names the parameters in the order of the variable's type.
+	Params []string `json:"params,omitempty"`
+	// Doc is the documentation following the bracketed name, e.g. "returns a mask of ...".
+	Doc string `json:"doc"`
+}
+
+// Struct describes a C struct or union and the Go type mirroring it.
+type Struct struct {
+	// Name is the C name, e.g. "SDL_AudioSpec".
+	Name string `json:"name"`
+	// Go is the name of the Go type, e.g. "AudioSpec".
+	Go string `json:"go"`
+	// Union reports whether the C type is a union. Unions are mirrored by byte arrays, so only their size is checked.
+	Union bool `json:"union,omitempty"`
+	// Size and Align are the size and the alignment of the C type.
+	Size  uintptr `json:"size"`
+	Align uintptr `json:"align"`
+	// Fields lists the fields to check, usually all but the padding.
+	Fields []*Field `json:"fields,omitempty"`
+}
+
+// Field describes a field of a C struct.
+type Field struct {
+	// Name is the C name, e.g. "mip_level".
+	Name string `json:"name"`
+	// Go is the name of the Go field, if it isn't the C name in camel case, e.g. "ID" for "id".
+	Go string `json:"go,omitempty"`
+	// Offset is the offset of the field in the C struct.
+	Offset uintptr `json:"offset"`
+}
+
+func loadAPI(path string) (*API, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	api := new(API)
+	if err := json.Unmarshal(data, api); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if err := api.validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return api, nil
+}
+
+func saveAPI(path string, api *API) error {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "\t")
+	if err := enc.Encode(api); err != nil {
+		return err
+	}
+	return os.WriteFile(path, buf.Bytes(), 0o644)
+}
+
+func (api *API) validate() error {
+	names := make(map[string]bool)
+	vars := make(map[string]bool)
+	for _, fn := range api.Functions {
+		switch {
+		case !strings.HasPrefix(fn.Name, api.Prefix):
+			return fmt.Errorf("%s: missing prefix %s", fn.Name, api.Prefix)
+		case names[fn.Name]:
+			return fmt.Errorf("%s: duplicate function", fn.Name)
+		case vars[fn.Var]:
+			return fmt.Errorf("%s: duplicate variable %s", fn.Name, fn.Var)
+		case fn.Type == "":
+			return fmt.Errorf("%s: missing type", fn.Name)
+		case fn.Wrapper != nil && !fn.Bind:
+			return fmt.Errorf("%s: wrapper for an unbound function", fn.Name)
+		case fn.Wrapper != nil && fn.Type == "uintptr":
+			return fmt.Errorf("%s: wrapper for a uintptr function", fn.Name)
+		}
+		names[fn.Name] = true
+		vars[fn.Var] = true
+	}
+	return nil
+}
+
+// sort orders the functions by their C name, ignoring case.
+func (api *API) sort() {
+	sort.SliceStable(api.Functions, func(i, j int) bool {
+		a, b := strings.ToLower(api.Functions[i].Name), strings.ToLower(api.Functions[j].Name)
+		if a == b {
+			return api.Functions[i].Name < api.Functions[j].Name
+		}
+		return a < b
+	})
+}
+
+// newer reports whether fn was introduced after the API's minimum version.
+func (api *API) newer(fn *Function) bool {
+	return fn.Since != "" && compareVersions(fn.Since, api.MinimumVersion) > 0
+}
+
+// compareVersions compares two dotted version numbers like "3.2.0".
+func compareVersions(a, b string) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		var x, y int
+		if i < len(as) {
+			fmt.Sscan(as[i], &x)
+		}
+		if i < len(bs) {
+			fmt.Sscan(bs[i], &y)
+		}
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
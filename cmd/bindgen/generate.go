@@ -0,0 +1,293 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"sort"
+	"strings"
+)
+
+const header = "// Code generated by bindgen from %s. DO NOT EDIT.\n\npackage %s\n\n"
+
+// generateFunctions returns the source of the function variables, the version table and registerFunctions.
+func generateFunctions(api *API, source string) ([]byte, error) {
+	var body bytes.Buffer
+
+	body.WriteString("var (\n")
+	for _, fn := range api.Functions {
+		if fn.Bind {
+			fmt.Fprintf(&body, "\t%s %s\n", fn.Var, fn.Type)
+		} else {
+			fmt.Fprintf(&body, "\t// %s %s\n", fn.Var, fn.Type)
+		}
+	}
+	body.WriteString(")\n\n")
+
+	fmt.Fprintf(&body, "// functionVersions maps the C functions introduced after version %s to the version introducing them.\n", api.MinimumVersion)
+	body.WriteString("var functionVersions = map[string]string{\n")
+	for _, fn := range api.Functions {
+		if api.newer(fn) {
+			fmt.Fprintf(&body, "\t%q: %q,\n", fn.Name, fn.Since)
+		}
+	}
+	body.WriteString("}\n\n")
+
+	var versions []string
+	newer := make(map[string][]*Function)
+	body.WriteString("func registerFunctions(lib uintptr) {\n")
+	for _, fn := range api.Functions {
+		if api.newer(fn) {
+			if newer[fn.Since] == nil {
+				versions = append(versions, fn.Since)
+			}
+			newer[fn.Since] = append(newer[fn.Since], fn)
+			continue
+		}
+		switch {
+		case fn.Type == "uintptr":
+			fmt.Fprintf(&body, "\t%s%s = shared.Get(lib, %q)\n", comment(fn), fn.Var, fn.Name)
+		default:
+			fmt.Fprintf(&body, "\t%spurego.RegisterLibFunc(&%s, lib, %q)\n", comment(fn), fn.Var, fn.Name)
+		}
+	}
+	sort.Slice(versions, func(i, j int) bool { return compareVersions(versions[i], versions[j]) < 0 })
+	for _, version := range versions {
+		fmt.Fprintf(&body, "\n\t// Functions available since %s. Missing ones are recorded instead of causing a panic.\n", version)
+		for _, fn := range newer[version] {
+			fmt.Fprintf(&body, "\t%sshared.Register(&%s, lib, %q)\n", comment(fn), fn.Var, fn.Name)
+		}
+	}
+	body.WriteString("}\n")
+
+	var bound []*Function
+	for _, fn := range api.Functions {
+		if fn.Bind {
+			bound = append(bound, fn)
+		}
+	}
+	imports := typeImports(api, bound)
+	if uses(bound, func(fn *Function) bool { return fn.Type != "uintptr" && !api.newer(fn) }) {
+		imports = append(imports, "github.com/ebitengine/purego")
+	}
+	if uses(bound, func(fn *Function) bool { return fn.Type == "uintptr" || api.newer(fn) }) {
+		imports = append(imports, "github.com/jupiterrider/purego-sdl3/internal/shared")
+	}
+	return finish(api, source, imports, body.Bytes())
+}
+
+// generateWrappers returns the source of the exported wrappers or nil if no function has one.
+func generateWrappers(api *API, source string) ([]byte, error) {
+	var body bytes.Buffer
+	var wrapped []*Function
+	for _, fn := range api.Functions {
+		if fn.Wrapper != nil {
+			wrapped = append(wrapped, fn)
+		}
+	}
+	if len(wrapped) == 0 {
+		return nil, nil
+	}
+	sort.SliceStable(wrapped, func(i, j int) bool { return wrapped[i].Wrapper.Name < wrapped[j].Wrapper.Name })
+
+	for i, fn := range wrapped {
+		typ, err := parser.ParseExpr(fn.Type)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", fn.Name, err)
+		}
+		ft, ok := typ.(*ast.FuncType)
+		if !ok {
+			return nil, fmt.Errorf("%s: type is not a function", fn.Name)
+		}
+
+		var params []string
+		if ft.Params != nil {
+			for _, field := range ft.Params.List {
+				params = append(params, expr(field.Type))
+			}
+		}
+		if len(params) != len(fn.Wrapper.Params) {
+			return nil, fmt.Errorf("%s: %d parameter names for %d parameters", fn.Name, len(fn.Wrapper.Params), len(params))
+		}
+		var result string
+		if ft.Results != nil && len(ft.Results.List) > 0 {
+			if len(ft.Results.List) > 1 {
+				return nil, fmt.Errorf("%s: multiple results", fn.Name)
+			}
+			result = expr(ft.Results.List[0].Type)
+		}
+
+		decl := make([]string, len(params))
+		for i, p := range params {
+			decl[i] = fn.Wrapper.Params[i] + " " + p
+		}
+		call := fmt.Sprintf("%s(%s)", fn.Var, strings.Join(fn.Wrapper.Params, ", "))
+
+		if i > 0 {
+			body.WriteString("\n")
+		}
+		fmt.Fprintf(&body, "// [%s] %s\n//\n", fn.Wrapper.Name, fn.Wrapper.Doc)
+		if api.newer(fn) {
+			fmt.Fprintf(&body, "// Available since %s %s.\n//\n", productName(api), fn.Since)
+		}
+		fmt.Fprintf(&body, "// [%s]: %s/%s\n", fn.Wrapper.Name, api.Wiki, fn.Name)
+		fmt.Fprintf(&body, "func %s(%s) %s {\n", fn.Wrapper.Name, strings.Join(decl, ", "), result)
+		if api.newer(fn) {
+			fmt.Fprintf(&body, "\tif %s == nil {\n\t\tnotAvailable(%q)\n", fn.Var, fn.Name)
+			if result != "" {
+				fmt.Fprintf(&body, "\t\treturn %s\n", zero(result))
+			} else {
+				body.WriteString("\t\treturn\n")
+			}
+			body.WriteString("\t}\n")
+		}
+		if result != "" {
+			fmt.Fprintf(&body, "\treturn %s\n}\n", call)
+		} else {
+			fmt.Fprintf(&body, "\t%s\n}\n", call)
+		}
+	}
+	return finish(api, source, typeImports(api, wrapped), body.Bytes())
+}
+
+// generateLayouts returns the source of the struct layouts or nil if the API has no structs.
+func generateLayouts(api *API, source string) ([]byte, error) {
+	if len(api.Structs) == 0 {
+		return nil, nil
+	}
+
+	var body bytes.Buffer
+	fmt.Fprintf(&body, "// abiPointerSize is the size of pointers on the platform the layouts were measured on.\n")
+	fmt.Fprintf(&body, "const abiPointerSize = %d\n\n", api.PointerSize)
+	fmt.Fprintf(&body, "// abiLayouts are the layouts of the C structs mirrored by Go types, as measured from the %s headers.\n", api.Library)
+	body.WriteString("var abiLayouts = []shared.Layout{\n")
+	for _, s := range api.Structs {
+		if s.Size == 0 {
+			return nil, fmt.Errorf("%s: not measured, run bindgen with -headers", s.Name)
+		}
+		fmt.Fprintf(&body, "\t{\n\t\tName: %q, Type: reflect.TypeOf(%s{}), Size: %d, Align: %d,\n", s.Name, s.Go, s.Size, s.Align)
+		if s.Union {
+			body.WriteString("\t\tUnion: true,\n")
+		}
+		if len(s.Fields) > 0 {
+			body.WriteString("\t\tFields: []shared.Field{\n")
+			for _, f := range s.Fields {
+				goName := f.Go
+				if goName == "" {
+					goName = camelCase(f.Name)
+				}
+				fmt.Fprintf(&body, "\t\t\t{Name: %q, Go: %q, Offset: %d},\n", f.Name, goName, f.Offset)
+			}
+			body.WriteString("\t\t},\n")
+		}
+		body.WriteString("\t},\n")
+	}
+	body.WriteString("}\n")
+
+	return finish(api, source, []string{"reflect", "github.com/jupiterrider/purego-sdl3/internal/shared"}, body.Bytes())
+}
+
+// productName returns the name of the library as used in the documentation, e.g. "SDL_image" for "SDL3_image".
+func productName(api *API) string {
+	return strings.Replace(api.Library, "3", "", 1)
+}
+
+// camelCase converts a C name like "mip_level" into a Go name like "MipLevel".
+func camelCase(name string) string {
+	var b strings.Builder
+	for _, part := range strings.Split(name, "_") {
+		if part != "" {
+			b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+		}
+	}
+	return b.String()
+}
+
+// typeImports returns the import paths needed by the types of fns.
+func typeImports(api *API, fns []*Function) []string {
+	var imports []string
+	if uses(fns, func(fn *Function) bool { return strings.Contains(fn.Type, "unsafe.") }) {
+		imports = append(imports, "unsafe")
+	}
+	if api.Package != "sdl" && uses(fns, func(fn *Function) bool { return strings.Contains(fn.Type, "sdl.") }) {
+		imports = append(imports, "github.com/jupiterrider/purego-sdl3/sdl")
+	}
+	return imports
+}
+
+// finish adds the header and the imports to body and formats the result.
+func finish(api *API, source string, imports []string, body []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, header, source, api.Package)
+
+	var std, ext []string
+	for _, path := range imports {
+		if strings.Contains(path, ".") {
+			ext = append(ext, path)
+		} else {
+			std = append(std, path)
+		}
+	}
+	sort.Strings(std)
+	sort.Strings(ext)
+	if len(std)+len(ext) > 0 {
+		buf.WriteString("import (\n")
+		for _, path := range std {
+			fmt.Fprintf(&buf, "\t%q\n", path)
+		}
+		if len(std) > 0 && len(ext) > 0 {
+			buf.WriteString("\n")
+		}
+		for _, path := range ext {
+			fmt.Fprintf(&buf, "\t%q\n", path)
+		}
+		buf.WriteString(")\n\n")
+	}
+	buf.Write(body)
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %w", err)
+	}
+	return src, nil
+}
+
+func comment(fn *Function) string {
+	if fn.Bind {
+		return ""
+	}
+	return "// "
+}
+
+func uses(fns []*Function, match func(fn *Function) bool) bool {
+	for _, fn := range fns {
+		if match(fn) {
+			return true
+		}
+	}
+	return false
+}
+
+func expr(e ast.Expr) string {
+	var buf bytes.Buffer
+	printer.Fprint(&buf, token.NewFileSet(), e)
+	return buf.String()
+}
+
+// zero returns the zero value of the Go type typ.
+func zero(typ string) string {
+	switch {
+	case typ == "bool":
+		return "false"
+	case typ == "string":
+		return `""`
+	case typ == "unsafe.Pointer" || strings.HasPrefix(typ, "*") || strings.HasPrefix(typ, "[]") || strings.HasPrefix(typ, "func"):
+		return "nil"
+	default:
+		return "0"
+	}
+}
@@ -0,0 +1,258 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// declaration is a function declared in a C header.
+type declaration struct {
+	name   string
+	result string
+	params []string
+	since  string
+}
+
+var (
+	// annotations matches the SDL macros for static analysis and attributes, which carry no type information.
+	annotations = regexp.MustCompile(`\bSDL_(?:OUT|IN|INOUT|PRINTF|SCANF|WPRINTF|MALLOC|ALLOC_SIZE|ACQUIRE|RELEASE|TRY_ACQUIRE|NO_THREAD_SAFETY|ANALYZER|DEPRECATED|NODISCARD)\w*(?:\s*\([^()]*\))?`)
+	since       = regexp.MustCompile(`\\since This function is available since \w+ (\d+\.\d+\.\d+)`)
+	identifier  = regexp.MustCompile(`^(.*?)\b(\w+)\s*(\[[^\]]*\])?$`)
+	spaces      = regexp.MustCompile(`\s+`)
+)
+
+// parseHeaders returns the functions declared in the headers of dir whose names start with prefix.
+func parseHeaders(dir, prefix string) ([]declaration, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.h"))
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no headers in %s", dir)
+	}
+
+	var decls []declaration
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		decls = append(decls, parseHeader(string(data), prefix)...)
+	}
+	return decls, nil
+}
+
+func parseHeader(src, prefix string) []declaration {
+	var decls []declaration
+	parts := strings.Split(src, "extern SDL_DECLSPEC ")
+	for i := 1; i < len(parts); i++ {
+		// The documentation precedes the declaration.
+		var version string
+		if doc := parts[i-1]; strings.Contains(doc, "/**") {
+			doc = doc[strings.LastIndex(doc, "/**"):]
+			if m := since.FindStringSubmatch(doc); m != nil {
+				version = m[1]
+			}
+		}
+
+		decl := parts[i]
+		end := strings.Index(decl, ";")
+		if end < 0 {
+			continue
+		}
+		decl = annotations.ReplaceAllString(decl[:end], "")
+		call := strings.Index(decl, "SDLCALL ")
+		open := strings.Index(decl, "(")
+		if call < 0 || open < call {
+			continue
+		}
+		name := strings.TrimSpace(decl[call+len("SDLCALL ") : open])
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		closing := strings.LastIndex(decl, ")")
+		if closing < open {
+			continue
+		}
+
+		d := declaration{name: name, result: normalize(decl[:call]), since: version}
+		for _, param := range splitParams(decl[open+1 : closing]) {
+			if param = normalize(param); param != "" && param != "void" && param != "..." {
+				d.params = append(d.params, param)
+			}
+		}
+		decls = append(decls, d)
+	}
+	return decls
+}
+
+// splitParams splits a parameter list at the commas outside of parentheses.
+func splitParams(list string) []string {
+	var params []string
+	depth, start := 0, 0
+	for i, r := range list {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				params = append(params, list[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(params, list[start:])
+}
+
+func normalize(s string) string {
+	s = strings.ReplaceAll(s, "*", " * ")
+	return strings.TrimSpace(spaces.ReplaceAllString(s, " "))
+}
+
+// goType returns the Go type of the C type typ, as used in the package of api.
+// Types without a Go equivalent are mapped to uintptr.
+func goType(api *API, typ string) string {
+	if strings.Contains(typ, "(") {
+		// function pointer
+		return "uintptr"
+	}
+
+	var pointers int
+	var words []string
+	for _, word := range strings.Fields(typ) {
+		switch word {
+		case "*":
+			pointers++
+		case "const", "struct", "enum", "volatile":
+		default:
+			words = append(words, word)
+		}
+	}
+	base := strings.Join(words, " ")
+
+	switch {
+	case base == "void" && pointers == 0:
+		return ""
+	case base == "void":
+		return strings.Repeat("*", pointers-1) + "unsafe.Pointer"
+	case base == "char" && pointers == 1:
+		return "string"
+	}
+
+	var goBase string
+	switch base {
+	case "char", "Sint8":
+		goBase = "int8"
+	case "unsigned char", "Uint8":
+		goBase = "uint8"
+	case "short", "Sint16":
+		goBase = "int16"
+	case "unsigned short", "Uint16":
+		goBase = "uint16"
+	case "int", "Sint32":
+		goBase = "int32"
+	case "unsigned int", "Uint32":
+		goBase = "uint32"
+	case "long", "long long", "Sint64":
+		goBase = "int64"
+	case "unsigned long", "unsigned long long", "Uint64", "size_t":
+		goBase = "uint64"
+	case "float":
+		goBase = "float32"
+	case "double":
+		goBase = "float64"
+	case "bool":
+		goBase = "bool"
+	case "wchar_t":
+		goBase = "int32"
+	default:
+		switch {
+		case strings.HasPrefix(base, api.Prefix):
+			goBase = strings.TrimPrefix(base, api.Prefix)
+		case strings.HasPrefix(base, "SDL_"):
+			goBase = "sdl." + strings.TrimPrefix(base, "SDL_")
+		default:
+			goBase = "uintptr"
+		}
+	}
+	if base == "char" && pointers > 1 {
+		goBase = "byte"
+	}
+	return strings.Repeat("*", pointers) + goBase
+}
+
+// paramType returns the type of a C parameter declaration, which may include a name and an array size.
+func paramType(param string) string {
+	m := identifier.FindStringSubmatch(param)
+	if m == nil || strings.TrimSpace(m[1]) == "" || strings.TrimSpace(m[1]) == "const" {
+		// no parameter name
+		return param
+	}
+	typ := m[1]
+	if m[3] != "" {
+		typ += " *"
+	}
+	return typ
+}
+
+// funcType returns the Go type of the function variable for d.
+func funcType(api *API, d declaration) string {
+	params := make([]string, len(d.params))
+	for i, param := range d.params {
+		params[i] = goType(api, paramType(param))
+	}
+	typ := "func(" + strings.Join(params, ", ") + ")"
+	if result := goType(api, d.result); result != "" {
+		typ += " " + result
+	}
+	return typ
+}
+
+// varName returns the name of the function variable for the C function name.
+func varName(api *API, name string) string {
+	return api.VarPrefix + strings.ReplaceAll(strings.TrimPrefix(name, api.Prefix), "_", "")
+}
+
+// merge updates api with the declarations. New functions are added unbound and the versions of all are refreshed.
+// The types of known functions are kept, because they may have been refined by hand.
+// Functions missing from the headers are reported but kept, since their wrappers may still refer to them.
+func merge(api *API, decls []declaration, report func(format string, args ...interface{})) {
+	known := make(map[string]*Function, len(api.Functions))
+	for _, fn := range api.Functions {
+		known[fn.Name] = fn
+	}
+
+	declared := make(map[string]bool, len(decls))
+	for _, d := range decls {
+		declared[d.name] = true
+
+		var version string
+		if d.since != "" && compareVersions(d.since, api.MinimumVersion) > 0 {
+			version = d.since
+		}
+
+		fn := known[d.name]
+		if fn == nil {
+			fn = &Function{Name: d.name, Var: varName(api, d.name), Type: funcType(api, d)}
+			api.Functions = append(api.Functions, fn)
+			known[d.name] = fn
+			report("added %s %s", fn.Name, fn.Type)
+		}
+		if fn.Since != version {
+			report("%s: version %q -> %q", fn.Name, fn.Since, version)
+			fn.Since = version
+		}
+	}
+
+	for _, fn := range api.Functions {
+		if !declared[fn.Name] {
+			report("%s is not declared in the headers", fn.Name)
+		}
+	}
+	api.sort()
+}
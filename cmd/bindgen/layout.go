@@ -0,0 +1,82 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// measureStructs updates the sizes, alignments and field offsets of the structs of api,
+// by compiling and running a C program including the headers in dir with the C compiler cc.
+func measureStructs(api *API, dir, cc string) error {
+	if len(api.Structs) == 0 {
+		return nil
+	}
+
+	var src bytes.Buffer
+	fmt.Fprintf(&src, "#include <stdio.h>\n#include <stddef.h>\n#include <%s>\n\nint main(void) {\n", api.Include)
+	src.WriteString("\tprintf(\"%zu\\n\", sizeof(void *));\n")
+	for _, s := range api.Structs {
+		fmt.Fprintf(&src, "\tprintf(\"%%zu %%zu\\n\", sizeof(%[1]s), _Alignof(%[1]s));\n", s.Name)
+		for _, f := range s.Fields {
+			fmt.Fprintf(&src, "\tprintf(\"%%zu\\n\", offsetof(%s, %s));\n", s.Name, f.Name)
+		}
+	}
+	src.WriteString("\treturn 0;\n}\n")
+
+	tmp, err := os.MkdirTemp("", "bindgen")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+
+	main := filepath.Join(tmp, "layout.c")
+	if err := os.WriteFile(main, src.Bytes(), 0o644); err != nil {
+		return err
+	}
+	prog := filepath.Join(tmp, "layout")
+	cmd := exec.Command(cc, "-std=c11", "-I", filepath.Dir(filepath.Clean(dir)), "-I", dir, "-o", prog, main)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("compiling the layout program: %w\n%s", err, out)
+	}
+	out, err := exec.Command(prog).Output()
+	if err != nil {
+		return fmt.Errorf("running the layout program: %w", err)
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	next := func(dst ...*uintptr) error {
+		if !scanner.Scan() {
+			return fmt.Errorf("unexpected end of the layout program output")
+		}
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != len(dst) {
+			return fmt.Errorf("unexpected layout program output %q", scanner.Text())
+		}
+		for i, field := range fields {
+			if _, err := fmt.Sscan(field, dst[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if err := next(&api.PointerSize); err != nil {
+		return err
+	}
+	for _, s := range api.Structs {
+		if err := next(&s.Size, &s.Align); err != nil {
+			return err
+		}
+		for _, f := range s.Fields {
+			if err := next(&f.Offset); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+// Command bindgen generates the function bindings of the sdl, img and ttf packages.
+//
+// The functions are described by a JSON file per library (see the api directory), listing the C name,
+// the Go function variable and its type, the version introducing the function and whether it is bound.
+// From that, bindgen writes functions_gen.go with the function variables, their registration and
+// the version metadata, wrappers_gen.go with the exported wrappers marked in the description and
+// abi_gen.go with the layouts of the C structs mirrored by Go types, which VerifyABI compares with the Go types.
+// Hand-written wrappers, which convert the C types into more convenient Go types, live in the other files.
+//
+// To bind a function, set "bind" to true, adjust its type if necessary and run go generate.
+//
+// With -headers, the description is first updated from the C headers in the given directory:
+// new functions are added unbound, versions are taken from the \since documentation and functions
+// no longer declared are reported. The structs are measured by compiling a small C program with -cc,
+// which must target the same platform as the Go code (64-bit). The updated description is written back to the JSON file.
+//
+// Usage:
+//
+//	go run ./cmd/bindgen -api cmd/bindgen/api/sdl3.json -out sdl [-headers /usr/include/SDL3]
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+func main() {
+	apiPath := flag.String("api", "", "path of the JSON `file` describing the library")
+	out := flag.String("out", ".", "`directory` of the Go package to write the generated files to")
+	headers := flag.String("headers", "", "`directory` of C headers to update the description from")
+	cc := flag.String("cc", "cc", "C `compiler` used to measure the structs with -headers")
+	flag.Parse()
+
+	if *apiPath == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err := run(*apiPath, *out, *headers, *cc); err != nil {
+		fmt.Fprintln(os.Stderr, "bindgen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(apiPath, out, headers, cc string) error {
+	api, err := loadAPI(apiPath)
+	if err != nil {
+		return err
+	}
+
+	if headers != "" {
+		decls, err := parseHeaders(headers, api.Prefix)
+		if err != nil {
+			return err
+		}
+		merge(api, decls, func(format string, args ...interface{}) {
+			fmt.Fprintf(os.Stderr, format+"\n", args...)
+		})
+		if err := measureStructs(api, headers, cc); err != nil {
+			return err
+		}
+		if err := saveAPI(apiPath, api); err != nil {
+			return err
+		}
+	}
+
+	source := filepath.Base(apiPath)
+	src, err := generateFunctions(api, source)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(out, "functions_gen.go"), src, 0o644); err != nil {
+		return err
+	}
+
+	src, err = generateWrappers(api, source)
+	if err != nil {
+		return err
+	}
+	if err := writeOptional(filepath.Join(out, "wrappers_gen.go"), src); err != nil {
+		return err
+	}
+
+	src, err = generateLayouts(api, source)
+	if err != nil {
+		return err
+	}
+	return writeOptional(filepath.Join(out, "abi_gen.go"), src)
+}
+
+// writeOptional writes src to path or removes the file if src is nil.
+func writeOptional(path string, src []byte) error {
+	if src == nil {
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		return nil
+	}
+	return os.WriteFile(path, src, 0o644)
+}
@@ -0,0 +1,18 @@
+package img
+
+import (
+	"github.com/jupiterrider/purego-sdl3/internal/shared"
+	"github.com/jupiterrider/purego-sdl3/sdl"
+)
+
+// VerifyABI is like [sdl.VerifyABI] for the Go types mirroring SDL_image structs.
+func VerifyABI() error {
+	mismatches, measured := shared.VerifyLayouts(abiLayouts, abiPointerSize)
+	switch {
+	case !measured:
+		return sdl.ErrABINotMeasured
+	case len(mismatches) > 0:
+		return &sdl.ABIError{Mismatches: mismatches}
+	}
+	return nil
+}
@@ -0,0 +1,18 @@
+package img
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jupiterrider/purego-sdl3/sdl"
+)
+
+func TestVerifyABI(t *testing.T) {
+	err := VerifyABI()
+	if errors.Is(err, sdl.ErrABINotMeasured) {
+		t.Skip(err)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,112 @@
+package img
+
+import (
+	"runtime"
+
+	"github.com/jupiterrider/purego-sdl3/sdl"
+)
+
+// LoadErr is like [Load], but returns an error on failure.
+func LoadErr(file string) (*sdl.Surface, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	surface := Load(file)
+	if surface == nil {
+		return nil, sdl.NewError("IMG_Load")
+	}
+	return surface, nil
+}
+
+// LoadIOErr is like [LoadIO], but returns an error on failure.
+func LoadIOErr(src *sdl.IOStream, closeio bool) (*sdl.Surface, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	surface := LoadIO(src, closeio)
+	if surface == nil {
+		return nil, sdl.NewError("IMG_Load_IO")
+	}
+	return surface, nil
+}
+
+// LoadTypedIOErr is like [LoadTypedIO], but returns an error on failure.
+func LoadTypedIOErr(src *sdl.IOStream, closeio bool, format string) (*sdl.Surface, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	surface := LoadTypedIO(src, closeio, format)
+	if surface == nil {
+		return nil, sdl.NewError("IMG_LoadTyped_IO")
+	}
+	return surface, nil
+}
+
+// LoadTextureErr is like [LoadTexture], but returns an error on failure.
+func LoadTextureErr(renderer *sdl.Renderer, file string) (*sdl.Texture, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	texture := LoadTexture(renderer, file)
+	if texture == nil {
+		return nil, sdl.NewError("IMG_LoadTexture")
+	}
+	return texture, nil
+}
+
+// LoadTextureIOErr is like [LoadTextureIO], but returns an error on failure.
+func LoadTextureIOErr(renderer *sdl.Renderer, src *sdl.IOStream, closeio bool) (*sdl.Texture, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	texture := LoadTextureIO(renderer, src, closeio)
+	if texture == nil {
+		return nil, sdl.NewError("IMG_LoadTexture_IO")
+	}
+	return texture, nil
+}
+
+// LoadAnimationErr is like [LoadAnimation], but returns an error on failure.
+func LoadAnimationErr(file string) (*Animation, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	anim := LoadAnimation(file)
+	if anim == nil {
+		return nil, sdl.NewError("IMG_LoadAnimation")
+	}
+	return anim, nil
+}
+
+// SavePNGErr is like [SavePNG], but returns an error on failure.
+func SavePNGErr(surface *sdl.Surface, file string) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return sdl.Check("IMG_SavePNG", SavePNG(surface, file))
+}
+
+// SaveJPGErr is like [SaveJPG], but returns an error on failure.
+func SaveJPGErr(surface *sdl.Surface, file string, quality int32) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return sdl.Check("IMG_SaveJPG", SaveJPG(surface, file, quality))
+}
+
+// SaveAVIFErr is like [SaveAVIF], but returns an error on failure.
+func SaveAVIFErr(surface *sdl.Surface, file string, quality int32) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return sdl.Check("IMG_SaveAVIF", SaveAVIF(surface, file, quality))
+}
+
+// SaveErr is like [Save], but returns an error on failure.
+//
+// Available since SDL_image 3.4.0.
+func SaveErr(surface *sdl.Surface, file string) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return sdl.Check("IMG_Save", Save(surface, file))
+}
+
+// notAvailable sets the error message for the C function fn, which the loaded library doesn't provide.
+func notAvailable(fn string) {
+	if version, ok := functionVersions[fn]; ok {
+		sdl.SetError("%s is not available in this SDL_image version (requires %s)", fn, version)
+	} else {
+		sdl.SetError("%s is not available in this SDL_image version", fn)
+	}
+}
@@ -0,0 +1,56 @@
+package img
+
+import (
+	"io/fs"
+	"path"
+	"runtime"
+	"strings"
+
+	"github.com/jupiterrider/purego-sdl3/sdl"
+)
+
+// LoadFS is like [Load], but loads the file name of fsys. See [sdl.IOFromFS].
+//
+// Like [Load], it passes the file extension to SDL_image as a hint for formats that can't be detected reliably, e.g. TGA.
+func LoadFS(fsys fs.FS, name string) (*sdl.Surface, error) {
+	src, err := sdl.IOFromFS(fsys, name)
+	if err != nil {
+		return nil, err
+	}
+	return LoadTypedIOErr(src, true, typeOf(name))
+}
+
+// LoadTextureFS is like [LoadTexture], but loads the file name of fsys. See [LoadFS].
+func LoadTextureFS(renderer *sdl.Renderer, fsys fs.FS, name string) (*sdl.Texture, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	src, err := sdl.IOFromFS(fsys, name)
+	if err != nil {
+		return nil, err
+	}
+	texture := LoadTextureTypedIO(renderer, src, true, typeOf(name))
+	if texture == nil {
+		return nil, sdl.NewError("IMG_LoadTextureTyped_IO")
+	}
+	return texture, nil
+}
+
+// LoadAnimationFS is like [LoadAnimation], but loads the file name of fsys. See [LoadFS].
+func LoadAnimationFS(fsys fs.FS, name string) (*Animation, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	src, err := sdl.IOFromFS(fsys, name)
+	if err != nil {
+		return nil, err
+	}
+	anim := LoadAnimationTypedIO(src, true, typeOf(name))
+	if anim == nil {
+		return nil, sdl.NewError("IMG_LoadAnimationTyped_IO")
+	}
+	return anim, nil
+}
+
+// typeOf returns the extension of name without the dot, e.g. "png".
+func typeOf(name string) string {
+	return strings.TrimPrefix(path.Ext(name), ".")
+}
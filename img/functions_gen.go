@@ -0,0 +1,268 @@
+// Code generated by bindgen from sdl3_image.json. DO NOT EDIT.
+
+package img
+
+import (
+	"github.com/ebitengine/purego"
+	"github.com/jupiterrider/purego-sdl3/internal/shared"
+	"github.com/jupiterrider/purego-sdl3/sdl"
+)
+
+var (
+	imgAddAnimationEncoderFrame             func(*AnimationEncoder, *sdl.Surface, uint64) bool
+	imgCloseAnimationDecoder                func(*AnimationDecoder) bool
+	imgCloseAnimationEncoder                func(*AnimationEncoder) bool
+	imgCreateAnimatedCursor                 func(*Animation, int32, int32) *sdl.Cursor
+	imgCreateAnimationDecoder               func(string) *AnimationDecoder
+	imgCreateAnimationDecoderIO             func(*sdl.IOStream, bool, string) *AnimationDecoder
+	imgCreateAnimationDecoderWithProperties func(sdl.PropertiesID) *AnimationDecoder
+	imgCreateAnimationEncoder               func(string) *AnimationEncoder
+	imgCreateAnimationEncoderIO             func(*sdl.IOStream, bool, string) *AnimationEncoder
+	imgCreateAnimationEncoderWithProperties func(sdl.PropertiesID) *AnimationEncoder
+	imgFreeAnimation                        func(*Animation)
+	imgGetAnimationDecoderFrame             func(*AnimationDecoder, **sdl.Surface, *uint64) bool
+	imgGetAnimationDecoderProperties        func(*AnimationDecoder) sdl.PropertiesID
+	imgGetAnimationDecoderStatus            func(*AnimationDecoder) AnimationDecoderStatus
+	imgGetClipboardImage                    func() *sdl.Surface
+	imgIsANI                                func(*sdl.IOStream) bool
+	imgIsAVIF                               func(*sdl.IOStream) bool
+	imgIsBMP                                func(*sdl.IOStream) bool
+	imgIsCUR                                func(*sdl.IOStream) bool
+	imgIsGIF                                func(*sdl.IOStream) bool
+	imgIsICO                                func(*sdl.IOStream) bool
+	imgIsJPG                                func(*sdl.IOStream) bool
+	imgIsJXL                                func(*sdl.IOStream) bool
+	imgIsLBM                                func(*sdl.IOStream) bool
+	imgIsPCX                                func(*sdl.IOStream) bool
+	imgIsPNG                                func(*sdl.IOStream) bool
+	imgIsPNM                                func(*sdl.IOStream) bool
+	imgIsQOI                                func(*sdl.IOStream) bool
+	imgIsSVG                                func(*sdl.IOStream) bool
+	imgIsTIF                                func(*sdl.IOStream) bool
+	imgIsWEBP                               func(*sdl.IOStream) bool
+	imgIsXCF                                func(*sdl.IOStream) bool
+	imgIsXPM                                func(*sdl.IOStream) bool
+	imgIsXV                                 func(*sdl.IOStream) bool
+	imgLoad                                 func(string) *sdl.Surface
+	imgLoadIO                               func(*sdl.IOStream, bool) *sdl.Surface
+	imgLoadANIAnimationIO                   func(*sdl.IOStream) *Animation
+	imgLoadAnimation                        func(string) *Animation
+	imgLoadAnimationIO                      func(*sdl.IOStream, bool) *Animation
+	imgLoadAnimationTypedIO                 func(*sdl.IOStream, bool, string) *Animation
+	imgLoadAPNGAnimationIO                  func(*sdl.IOStream) *Animation
+	imgLoadAVIFIO                           func(*sdl.IOStream) *sdl.Surface
+	imgLoadAVIFAnimationIO                  func(*sdl.IOStream) *Animation
+	imgLoadBMPIO                            func(*sdl.IOStream) *sdl.Surface
+	imgLoadCURIO                            func(*sdl.IOStream) *sdl.Surface
+	imgLoadGIFIO                            func(*sdl.IOStream) *sdl.Surface
+	imgLoadGIFAnimationIO                   func(*sdl.IOStream) *Animation
+	imgLoadGPUTexture                       func(*sdl.GPUDevice, *sdl.GPUCopyPass, string, *int32, *int32) *sdl.GPUTexture
+	imgLoadGPUTextureIO                     func(*sdl.GPUDevice, *sdl.GPUCopyPass, *sdl.IOStream, bool, *int32, *int32) *sdl.GPUTexture
+	imgLoadGPUTextureTypedIO                func(*sdl.GPUDevice, *sdl.GPUCopyPass, *sdl.IOStream, bool, string, *int32, *int32) *sdl.GPUTexture
+	imgLoadICOIO                            func(*sdl.IOStream) *sdl.Surface
+	imgLoadJPGIO                            func(*sdl.IOStream) *sdl.Surface
+	imgLoadJXLIO                            func(*sdl.IOStream) *sdl.Surface
+	imgLoadLBMIO                            func(*sdl.IOStream) *sdl.Surface
+	imgLoadPCXIO                            func(*sdl.IOStream) *sdl.Surface
+	imgLoadPNGIO                            func(*sdl.IOStream) *sdl.Surface
+	imgLoadPNMIO                            func(*sdl.IOStream) *sdl.Surface
+	imgLoadQOIIO                            func(*sdl.IOStream) *sdl.Surface
+	imgLoadSizedSVGIO                       func(*sdl.IOStream, int32, int32) *sdl.Surface
+	imgLoadSVGIO                            func(*sdl.IOStream) *sdl.Surface
+	imgLoadTexture                          func(*sdl.Renderer, string) *sdl.Texture
+	imgLoadTextureIO                        func(*sdl.Renderer, *sdl.IOStream, bool) *sdl.Texture
+	imgLoadTextureTypedIO                   func(*sdl.Renderer, *sdl.IOStream, bool, string) *sdl.Texture
+	imgLoadTGAIO                            func(*sdl.IOStream) *sdl.Surface
+	imgLoadTIFIO                            func(*sdl.IOStream) *sdl.Surface
+	imgLoadTypedIO                          func(*sdl.IOStream, bool, string) *sdl.Surface
+	imgLoadWEBPIO                           func(*sdl.IOStream) *sdl.Surface
+	imgLoadWEBPAnimationIO                  func(*sdl.IOStream) *Animation
+	imgLoadXCFIO                            func(*sdl.IOStream) *sdl.Surface
+	imgLoadXPMIO                            func(*sdl.IOStream) *sdl.Surface
+	imgLoadXVIO                             func(*sdl.IOStream) *sdl.Surface
+	imgReadXPMFromArray                     func(**byte) *sdl.Surface
+	imgReadXPMFromArrayToRGB888             func(**byte) *sdl.Surface
+	imgResetAnimationDecoder                func(*AnimationDecoder) bool
+	imgSave                                 func(*sdl.Surface, string) bool
+	imgSaveANIAnimationIO                   func(*Animation, *sdl.IOStream, bool) bool
+	imgSaveAnimation                        func(*Animation, string) bool
+	imgSaveAnimationTypedIO                 func(*Animation, *sdl.IOStream, bool, string) bool
+	imgSaveAPNGAnimationIO                  func(*Animation, *sdl.IOStream, bool) bool
+	imgSaveAVIF                             func(*sdl.Surface, string, int32) bool
+	imgSaveAVIFIO                           func(*sdl.Surface, *sdl.IOStream, bool, int32) bool
+	imgSaveAVIFAnimationIO                  func(*Animation, *sdl.IOStream, bool, int32) bool
+	imgSaveBMP                              func(*sdl.Surface, string) bool
+	imgSaveBMPIO                            func(*sdl.Surface, *sdl.IOStream, bool) bool
+	imgSaveCUR                              func(*sdl.Surface, string) bool
+	imgSaveCURIO                            func(*sdl.Surface, *sdl.IOStream, bool) bool
+	imgSaveGIF                              func(*sdl.Surface, string) bool
+	imgSaveGIFIO                            func(*sdl.Surface, *sdl.IOStream, bool) bool
+	imgSaveGIFAnimationIO                   func(*Animation, *sdl.IOStream, bool) bool
+	imgSaveICO                              func(*sdl.Surface, string) bool
+	imgSaveICOIO                            func(*sdl.Surface, *sdl.IOStream, bool) bool
+	imgSaveJPG                              func(*sdl.Surface, string, int32) bool
+	imgSaveJPGIO                            func(*sdl.Surface, *sdl.IOStream, bool, int32) bool
+	imgSavePNG                              func(*sdl.Surface, string) bool
+	imgSavePNGIO                            func(*sdl.Surface, *sdl.IOStream, bool) bool
+	imgSaveTGA                              func(*sdl.Surface, string) bool
+	imgSaveTGAIO                            func(*sdl.Surface, *sdl.IOStream, bool) bool
+	imgSaveTypedIO                          func(*sdl.Surface, *sdl.IOStream, bool, string) bool
+	imgSaveWEBP                             func(*sdl.Surface, string, float32) bool
+	imgSaveWEBPIO                           func(*sdl.Surface, *sdl.IOStream, bool, float32) bool
+	imgSaveWEBPAnimationIO                  func(*Animation, *sdl.IOStream, bool, int32) bool
+	imgVersion                              func() int32
+)
+
+// functionVersions maps the C functions introduced after version 3.2.0 to the version introducing them.
+var functionVersions = map[string]string{
+	"IMG_AddAnimationEncoderFrame":             "3.4.0",
+	"IMG_CloseAnimationDecoder":                "3.4.0",
+	"IMG_CloseAnimationEncoder":                "3.4.0",
+	"IMG_CreateAnimatedCursor":                 "3.4.0",
+	"IMG_CreateAnimationDecoder":               "3.4.0",
+	"IMG_CreateAnimationDecoder_IO":            "3.4.0",
+	"IMG_CreateAnimationDecoderWithProperties": "3.4.0",
+	"IMG_CreateAnimationEncoder":               "3.4.0",
+	"IMG_CreateAnimationEncoder_IO":            "3.4.0",
+	"IMG_CreateAnimationEncoderWithProperties": "3.4.0",
+	"IMG_GetAnimationDecoderFrame":             "3.4.0",
+	"IMG_GetAnimationDecoderProperties":        "3.4.0",
+	"IMG_GetAnimationDecoderStatus":            "3.4.0",
+	"IMG_GetClipboardImage":                    "3.4.0",
+	"IMG_isANI":                                "3.4.0",
+	"IMG_LoadANIAnimation_IO":                  "3.4.0",
+	"IMG_LoadAPNGAnimation_IO":                 "3.4.0",
+	"IMG_LoadAVIFAnimation_IO":                 "3.4.0",
+	"IMG_LoadGPUTexture":                       "3.4.0",
+	"IMG_LoadGPUTexture_IO":                    "3.4.0",
+	"IMG_LoadGPUTextureTyped_IO":               "3.4.0",
+	"IMG_ResetAnimationDecoder":                "3.4.0",
+	"IMG_Save":                                 "3.4.0",
+	"IMG_SaveANIAnimation_IO":                  "3.4.0",
+	"IMG_SaveAnimation":                        "3.4.0",
+	"IMG_SaveAnimationTyped_IO":                "3.4.0",
+	"IMG_SaveAPNGAnimation_IO":                 "3.4.0",
+	"IMG_SaveAVIFAnimation_IO":                 "3.4.0",
+	"IMG_SaveBMP":                              "3.4.0",
+	"IMG_SaveBMP_IO":                           "3.4.0",
+	"IMG_SaveCUR":                              "3.4.0",
+	"IMG_SaveCUR_IO":                           "3.4.0",
+	"IMG_SaveGIF":                              "3.4.0",
+	"IMG_SaveGIF_IO":                           "3.4.0",
+	"IMG_SaveGIFAnimation_IO":                  "3.4.0",
+	"IMG_SaveICO":                              "3.4.0",
+	"IMG_SaveICO_IO":                           "3.4.0",
+	"IMG_SaveTGA":                              "3.4.0",
+	"IMG_SaveTGA_IO":                           "3.4.0",
+	"IMG_SaveTyped_IO":                         "3.4.0",
+	"IMG_SaveWEBP":                             "3.4.0",
+	"IMG_SaveWEBP_IO":                          "3.4.0",
+	"IMG_SaveWEBPAnimation_IO":                 "3.4.0",
+}
+
+func registerFunctions(lib uintptr) {
+	purego.RegisterLibFunc(&imgFreeAnimation, lib, "IMG_FreeAnimation")
+	purego.RegisterLibFunc(&imgIsAVIF, lib, "IMG_isAVIF")
+	purego.RegisterLibFunc(&imgIsBMP, lib, "IMG_isBMP")
+	purego.RegisterLibFunc(&imgIsCUR, lib, "IMG_isCUR")
+	purego.RegisterLibFunc(&imgIsGIF, lib, "IMG_isGIF")
+	purego.RegisterLibFunc(&imgIsICO, lib, "IMG_isICO")
+	purego.RegisterLibFunc(&imgIsJPG, lib, "IMG_isJPG")
+	purego.RegisterLibFunc(&imgIsJXL, lib, "IMG_isJXL")
+	purego.RegisterLibFunc(&imgIsLBM, lib, "IMG_isLBM")
+	purego.RegisterLibFunc(&imgIsPCX, lib, "IMG_isPCX")
+	purego.RegisterLibFunc(&imgIsPNG, lib, "IMG_isPNG")
+	purego.RegisterLibFunc(&imgIsPNM, lib, "IMG_isPNM")
+	purego.RegisterLibFunc(&imgIsQOI, lib, "IMG_isQOI")
+	purego.RegisterLibFunc(&imgIsSVG, lib, "IMG_isSVG")
+	purego.RegisterLibFunc(&imgIsTIF, lib, "IMG_isTIF")
+	purego.RegisterLibFunc(&imgIsWEBP, lib, "IMG_isWEBP")
+	purego.RegisterLibFunc(&imgIsXCF, lib, "IMG_isXCF")
+	purego.RegisterLibFunc(&imgIsXPM, lib, "IMG_isXPM")
+	purego.RegisterLibFunc(&imgIsXV, lib, "IMG_isXV")
+	purego.RegisterLibFunc(&imgLoad, lib, "IMG_Load")
+	purego.RegisterLibFunc(&imgLoadIO, lib, "IMG_Load_IO")
+	purego.RegisterLibFunc(&imgLoadAnimation, lib, "IMG_LoadAnimation")
+	purego.RegisterLibFunc(&imgLoadAnimationIO, lib, "IMG_LoadAnimation_IO")
+	purego.RegisterLibFunc(&imgLoadAnimationTypedIO, lib, "IMG_LoadAnimationTyped_IO")
+	purego.RegisterLibFunc(&imgLoadAVIFIO, lib, "IMG_LoadAVIF_IO")
+	purego.RegisterLibFunc(&imgLoadBMPIO, lib, "IMG_LoadBMP_IO")
+	purego.RegisterLibFunc(&imgLoadCURIO, lib, "IMG_LoadCUR_IO")
+	purego.RegisterLibFunc(&imgLoadGIFIO, lib, "IMG_LoadGIF_IO")
+	purego.RegisterLibFunc(&imgLoadGIFAnimationIO, lib, "IMG_LoadGIFAnimation_IO")
+	purego.RegisterLibFunc(&imgLoadICOIO, lib, "IMG_LoadICO_IO")
+	purego.RegisterLibFunc(&imgLoadJPGIO, lib, "IMG_LoadJPG_IO")
+	purego.RegisterLibFunc(&imgLoadJXLIO, lib, "IMG_LoadJXL_IO")
+	purego.RegisterLibFunc(&imgLoadLBMIO, lib, "IMG_LoadLBM_IO")
+	purego.RegisterLibFunc(&imgLoadPCXIO, lib, "IMG_LoadPCX_IO")
+	purego.RegisterLibFunc(&imgLoadPNGIO, lib, "IMG_LoadPNG_IO")
+	purego.RegisterLibFunc(&imgLoadPNMIO, lib, "IMG_LoadPNM_IO")
+	purego.RegisterLibFunc(&imgLoadQOIIO, lib, "IMG_LoadQOI_IO")
+	purego.RegisterLibFunc(&imgLoadSizedSVGIO, lib, "IMG_LoadSizedSVG_IO")
+	purego.RegisterLibFunc(&imgLoadSVGIO, lib, "IMG_LoadSVG_IO")
+	purego.RegisterLibFunc(&imgLoadTexture, lib, "IMG_LoadTexture")
+	purego.RegisterLibFunc(&imgLoadTextureIO, lib, "IMG_LoadTexture_IO")
+	purego.RegisterLibFunc(&imgLoadTextureTypedIO, lib, "IMG_LoadTextureTyped_IO")
+	purego.RegisterLibFunc(&imgLoadTGAIO, lib, "IMG_LoadTGA_IO")
+	purego.RegisterLibFunc(&imgLoadTIFIO, lib, "IMG_LoadTIF_IO")
+	purego.RegisterLibFunc(&imgLoadTypedIO, lib, "IMG_LoadTyped_IO")
+	purego.RegisterLibFunc(&imgLoadWEBPIO, lib, "IMG_LoadWEBP_IO")
+	purego.RegisterLibFunc(&imgLoadWEBPAnimationIO, lib, "IMG_LoadWEBPAnimation_IO")
+	purego.RegisterLibFunc(&imgLoadXCFIO, lib, "IMG_LoadXCF_IO")
+	purego.RegisterLibFunc(&imgLoadXPMIO, lib, "IMG_LoadXPM_IO")
+	purego.RegisterLibFunc(&imgLoadXVIO, lib, "IMG_LoadXV_IO")
+	purego.RegisterLibFunc(&imgReadXPMFromArray, lib, "IMG_ReadXPMFromArray")
+	purego.RegisterLibFunc(&imgReadXPMFromArrayToRGB888, lib, "IMG_ReadXPMFromArrayToRGB888")
+	purego.RegisterLibFunc(&imgSaveAVIF, lib, "IMG_SaveAVIF")
+	purego.RegisterLibFunc(&imgSaveAVIFIO, lib, "IMG_SaveAVIF_IO")
+	purego.RegisterLibFunc(&imgSaveJPG, lib, "IMG_SaveJPG")
+	purego.RegisterLibFunc(&imgSaveJPGIO, lib, "IMG_SaveJPG_IO")
+	purego.RegisterLibFunc(&imgSavePNG, lib, "IMG_SavePNG")
+	purego.RegisterLibFunc(&imgSavePNGIO, lib, "IMG_SavePNG_IO")
+	purego.RegisterLibFunc(&imgVersion, lib, "IMG_Version")
+
+	// Functions available since 3.4.0. Missing ones are recorded instead of causing a panic.
+	shared.Register(&imgAddAnimationEncoderFrame, lib, "IMG_AddAnimationEncoderFrame")
+	shared.Register(&imgCloseAnimationDecoder, lib, "IMG_CloseAnimationDecoder")
+	shared.Register(&imgCloseAnimationEncoder, lib, "IMG_CloseAnimationEncoder")
+	shared.Register(&imgCreateAnimatedCursor, lib, "IMG_CreateAnimatedCursor")
+	shared.Register(&imgCreateAnimationDecoder, lib, "IMG_CreateAnimationDecoder")
+	shared.Register(&imgCreateAnimationDecoderIO, lib, "IMG_CreateAnimationDecoder_IO")
+	shared.Register(&imgCreateAnimationDecoderWithProperties, lib, "IMG_CreateAnimationDecoderWithProperties")
+	shared.Register(&imgCreateAnimationEncoder, lib, "IMG_CreateAnimationEncoder")
+	shared.Register(&imgCreateAnimationEncoderIO, lib, "IMG_CreateAnimationEncoder_IO")
+	shared.Register(&imgCreateAnimationEncoderWithProperties, lib, "IMG_CreateAnimationEncoderWithProperties")
+	shared.Register(&imgGetAnimationDecoderFrame, lib, "IMG_GetAnimationDecoderFrame")
+	shared.Register(&imgGetAnimationDecoderProperties, lib, "IMG_GetAnimationDecoderProperties")
+	shared.Register(&imgGetAnimationDecoderStatus, lib, "IMG_GetAnimationDecoderStatus")
+	shared.Register(&imgGetClipboardImage, lib, "IMG_GetClipboardImage")
+	shared.Register(&imgIsANI, lib, "IMG_isANI")
+	shared.Register(&imgLoadANIAnimationIO, lib, "IMG_LoadANIAnimation_IO")
+	shared.Register(&imgLoadAPNGAnimationIO, lib, "IMG_LoadAPNGAnimation_IO")
+	shared.Register(&imgLoadAVIFAnimationIO, lib, "IMG_LoadAVIFAnimation_IO")
+	shared.Register(&imgLoadGPUTexture, lib, "IMG_LoadGPUTexture")
+	shared.Register(&imgLoadGPUTextureIO, lib, "IMG_LoadGPUTexture_IO")
+	shared.Register(&imgLoadGPUTextureTypedIO, lib, "IMG_LoadGPUTextureTyped_IO")
+	shared.Register(&imgResetAnimationDecoder, lib, "IMG_ResetAnimationDecoder")
+	shared.Register(&imgSave, lib, "IMG_Save")
+	shared.Register(&imgSaveANIAnimationIO, lib, "IMG_SaveANIAnimation_IO")
+	shared.Register(&imgSaveAnimation, lib, "IMG_SaveAnimation")
+	shared.Register(&imgSaveAnimationTypedIO, lib, "IMG_SaveAnimationTyped_IO")
+	shared.Register(&imgSaveAPNGAnimationIO, lib, "IMG_SaveAPNGAnimation_IO")
+	shared.Register(&imgSaveAVIFAnimationIO, lib, "IMG_SaveAVIFAnimation_IO")
+	shared.Register(&imgSaveBMP, lib, "IMG_SaveBMP")
+	shared.Register(&imgSaveBMPIO, lib, "IMG_SaveBMP_IO")
+	shared.Register(&imgSaveCUR, lib, "IMG_SaveCUR")
+	shared.Register(&imgSaveCURIO, lib, "IMG_SaveCUR_IO")
+	shared.Register(&imgSaveGIF, lib, "IMG_SaveGIF")
+	shared.Register(&imgSaveGIFIO, lib, "IMG_SaveGIF_IO")
+	shared.Register(&imgSaveGIFAnimationIO, lib, "IMG_SaveGIFAnimation_IO")
+	shared.Register(&imgSaveICO, lib, "IMG_SaveICO")
+	shared.Register(&imgSaveICOIO, lib, "IMG_SaveICO_IO")
+	shared.Register(&imgSaveTGA, lib, "IMG_SaveTGA")
+	shared.Register(&imgSaveTGAIO, lib, "IMG_SaveTGA_IO")
+	shared.Register(&imgSaveTypedIO, lib, "IMG_SaveTyped_IO")
+	shared.Register(&imgSaveWEBP, lib, "IMG_SaveWEBP")
+	shared.Register(&imgSaveWEBPIO, lib, "IMG_SaveWEBP_IO")
+	shared.Register(&imgSaveWEBPAnimationIO, lib, "IMG_SaveWEBPAnimation_IO")
+}
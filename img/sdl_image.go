@@ -450,6 +450,10 @@ func SavePNGIO(surface *sdl.Surface, dst *sdl.IOStream, closeio bool) bool {
 //
 // [LoadGPUTexture]: https://wiki.libsdl.org/SDL3_image/IMG_LoadGPUTexture
 func LoadGPUTexture(device *sdl.GPUDevice, copyPass *sdl.GPUCopyPass, file string, width, height *int32) *sdl.GPUTexture {
+	if imgLoadGPUTexture == nil {
+		notAvailable("IMG_LoadGPUTexture")
+		return nil
+	}
 	return imgLoadGPUTexture(device, copyPass, file, width, height)
 }
 
@@ -459,6 +463,10 @@ func LoadGPUTexture(device *sdl.GPUDevice, copyPass *sdl.GPUCopyPass, file strin
 //
 // [LoadGPUTextureIO]: https://wiki.libsdl.org/SDL3_image/IMG_LoadGPUTexture_IO
 func LoadGPUTextureIO(device *sdl.GPUDevice, copyPass *sdl.GPUCopyPass, src *sdl.IOStream, closeio bool, width, height *int32) *sdl.GPUTexture {
+	if imgLoadGPUTextureIO == nil {
+		notAvailable("IMG_LoadGPUTexture_IO")
+		return nil
+	}
 	return imgLoadGPUTextureIO(device, copyPass, src, closeio, width, height)
 }
 
@@ -468,6 +476,10 @@ func LoadGPUTextureIO(device *sdl.GPUDevice, copyPass *sdl.GPUCopyPass, src *sdl
 //
 // [LoadGPUTextureTypedIO]: https://wiki.libsdl.org/SDL3_image/IMG_LoadGPUTextureTyped_IO
 func LoadGPUTextureTypedIO(device *sdl.GPUDevice, copyPass *sdl.GPUCopyPass, src *sdl.IOStream, closeio bool, _type string, width, height *int32) *sdl.GPUTexture {
+	if imgLoadGPUTextureTypedIO == nil {
+		notAvailable("IMG_LoadGPUTextureTyped_IO")
+		return nil
+	}
 	return imgLoadGPUTextureTypedIO(device, copyPass, src, closeio, _type, width, height)
 }
 
@@ -477,6 +489,10 @@ func LoadGPUTextureTypedIO(device *sdl.GPUDevice, copyPass *sdl.GPUCopyPass, src
 //
 // [GetClipboardImage]: https://wiki.libsdl.org/SDL3_image/IMG_GetClipboardImage
 func GetClipboardImage() *sdl.Surface {
+	if imgGetClipboardImage == nil {
+		notAvailable("IMG_GetClipboardImage")
+		return nil
+	}
 	return imgGetClipboardImage()
 }
 
@@ -486,6 +502,10 @@ func GetClipboardImage() *sdl.Surface {
 //
 // [IsANI]: https://wiki.libsdl.org/SDL3_image/IMG_IsANI
 func IsANI(src *sdl.IOStream) bool {
+	if imgIsANI == nil {
+		notAvailable("IMG_isANI")
+		return false
+	}
 	return imgIsANI(src)
 }
 
@@ -495,6 +515,10 @@ func IsANI(src *sdl.IOStream) bool {
 //
 // [Save]: https://wiki.libsdl.org/SDL3_image/IMG_Save
 func Save(surface *sdl.Surface, file string) bool {
+	if imgSave == nil {
+		notAvailable("IMG_Save")
+		return false
+	}
 	return imgSave(surface, file)
 }
 
@@ -504,6 +528,10 @@ func Save(surface *sdl.Surface, file string) bool {
 //
 // [SaveTypedIO]: https://wiki.libsdl.org/SDL3_image/IMG_SaveTyped_IO
 func SaveTypedIO(surface *sdl.Surface, dst *sdl.IOStream, closeio bool, _type string) bool {
+	if imgSaveTypedIO == nil {
+		notAvailable("IMG_SaveTyped_IO")
+		return false
+	}
 	return imgSaveTypedIO(surface, dst, closeio, _type)
 }
 
@@ -513,6 +541,10 @@ func SaveTypedIO(surface *sdl.Surface, dst *sdl.IOStream, closeio bool, _type st
 //
 // [SaveBMP]: https://wiki.libsdl.org/SDL3_image/IMG_SaveBMP
 func SaveBMP(surface *sdl.Surface, file string) bool {
+	if imgSaveBMP == nil {
+		notAvailable("IMG_SaveBMP")
+		return false
+	}
 	return imgSaveBMP(surface, file)
 }
 
@@ -522,6 +554,10 @@ func SaveBMP(surface *sdl.Surface, file string) bool {
 //
 // [SaveBMPIO]: https://wiki.libsdl.org/SDL3_image/IMG_SaveBMP_IO
 func SaveBMPIO(surface *sdl.Surface, dst *sdl.IOStream, closeio bool) bool {
+	if imgSaveBMPIO == nil {
+		notAvailable("IMG_SaveBMP_IO")
+		return false
+	}
 	return imgSaveBMPIO(surface, dst, closeio)
 }
 
@@ -531,6 +567,10 @@ func SaveBMPIO(surface *sdl.Surface, dst *sdl.IOStream, closeio bool) bool {
 //
 // [SaveCUR]: https://wiki.libsdl.org/SDL3_image/IMG_SaveCUR
 func SaveCUR(surface *sdl.Surface, file string) bool {
+	if imgSaveCUR == nil {
+		notAvailable("IMG_SaveCUR")
+		return false
+	}
 	return imgSaveCUR(surface, file)
 }
 
@@ -540,6 +580,10 @@ func SaveCUR(surface *sdl.Surface, file string) bool {
 //
 // [SaveCURIO]: https://wiki.libsdl.org/SDL3_image/IMG_SaveCUR_IO
 func SaveCURIO(surface *sdl.Surface, dst *sdl.IOStream, closeio bool) bool {
+	if imgSaveCURIO == nil {
+		notAvailable("IMG_SaveCUR_IO")
+		return false
+	}
 	return imgSaveCURIO(surface, dst, closeio)
 }
 
@@ -549,6 +593,10 @@ func SaveCURIO(surface *sdl.Surface, dst *sdl.IOStream, closeio bool) bool {
 //
 // [SaveGIF]: https://wiki.libsdl.org/SDL3_image/IMG_SaveGIF
 func SaveGIF(surface *sdl.Surface, file string) bool {
+	if imgSaveGIF == nil {
+		notAvailable("IMG_SaveGIF")
+		return false
+	}
 	return imgSaveGIF(surface, file)
 }
 
@@ -558,6 +606,10 @@ func SaveGIF(surface *sdl.Surface, file string) bool {
 //
 // [SaveGIFIO]: https://wiki.libsdl.org/SDL3_image/IMG_SaveGIF_IO
 func SaveGIFIO(surface *sdl.Surface, dst *sdl.IOStream, closeio bool) bool {
+	if imgSaveGIFIO == nil {
+		notAvailable("IMG_SaveGIF_IO")
+		return false
+	}
 	return imgSaveGIFIO(surface, dst, closeio)
 }
 
@@ -567,6 +619,10 @@ func SaveGIFIO(surface *sdl.Surface, dst *sdl.IOStream, closeio bool) bool {
 //
 // [SaveICO]: https://wiki.libsdl.org/SDL3_image/IMG_SaveICO
 func SaveICO(surface *sdl.Surface, file string) bool {
+	if imgSaveICO == nil {
+		notAvailable("IMG_SaveICO")
+		return false
+	}
 	return imgSaveICO(surface, file)
 }
 
@@ -576,6 +632,10 @@ func SaveICO(surface *sdl.Surface, file string) bool {
 //
 // [SaveICOIO]: https://wiki.libsdl.org/SDL3_image/IMG_SaveICO_IO
 func SaveICOIO(surface *sdl.Surface, dst *sdl.IOStream, closeio bool) bool {
+	if imgSaveICOIO == nil {
+		notAvailable("IMG_SaveICO_IO")
+		return false
+	}
 	return imgSaveICOIO(surface, dst, closeio)
 }
 
@@ -585,6 +645,10 @@ func SaveICOIO(surface *sdl.Surface, dst *sdl.IOStream, closeio bool) bool {
 //
 // [SaveTGA]: https://wiki.libsdl.org/SDL3_image/IMG_SaveTGA
 func SaveTGA(surface *sdl.Surface, file string) bool {
+	if imgSaveTGA == nil {
+		notAvailable("IMG_SaveTGA")
+		return false
+	}
 	return imgSaveTGA(surface, file)
 }
 
@@ -594,6 +658,10 @@ func SaveTGA(surface *sdl.Surface, file string) bool {
 //
 // [SaveTGAIO]: https://wiki.libsdl.org/SDL3_image/IMG_SaveTGA_IO
 func SaveTGAIO(surface *sdl.Surface, dst *sdl.IOStream, closeio bool) bool {
+	if imgSaveTGAIO == nil {
+		notAvailable("IMG_SaveTGA_IO")
+		return false
+	}
 	return imgSaveTGAIO(surface, dst, closeio)
 }
 
@@ -603,6 +671,10 @@ func SaveTGAIO(surface *sdl.Surface, dst *sdl.IOStream, closeio bool) bool {
 //
 // [SaveWEBP]: https://wiki.libsdl.org/SDL3_image/IMG_SaveWEBP
 func SaveWEBP(surface *sdl.Surface, file string, quality float32) bool {
+	if imgSaveWEBP == nil {
+		notAvailable("IMG_SaveWEBP")
+		return false
+	}
 	return imgSaveWEBP(surface, file, quality)
 }
 
@@ -612,6 +684,10 @@ func SaveWEBP(surface *sdl.Surface, file string, quality float32) bool {
 //
 // [SaveWEBPIO]: https://wiki.libsdl.org/SDL3_image/IMG_SaveWEBP_IO
 func SaveWEBPIO(surface *sdl.Surface, dst *sdl.IOStream, closeio bool, quality float32) bool {
+	if imgSaveWEBPIO == nil {
+		notAvailable("IMG_SaveWEBP_IO")
+		return false
+	}
 	return imgSaveWEBPIO(surface, dst, closeio, quality)
 }
 
@@ -621,6 +697,10 @@ func SaveWEBPIO(surface *sdl.Surface, dst *sdl.IOStream, closeio bool, quality f
 //
 // [LoadANIAnimationIO]: https://wiki.libsdl.org/SDL3_image/IMG_LoadANIAnimation_IO
 func LoadANIAnimationIO(src *sdl.IOStream) *Animation {
+	if imgLoadANIAnimationIO == nil {
+		notAvailable("IMG_LoadANIAnimation_IO")
+		return nil
+	}
 	return imgLoadANIAnimationIO(src)
 }
 
@@ -630,6 +710,10 @@ func LoadANIAnimationIO(src *sdl.IOStream) *Animation {
 //
 // [LoadAPNGAnimationIO]: https://wiki.libsdl.org/SDL3_image/IMG_LoadAPNGAnimation_IO
 func LoadAPNGAnimationIO(src *sdl.IOStream) *Animation {
+	if imgLoadAPNGAnimationIO == nil {
+		notAvailable("IMG_LoadAPNGAnimation_IO")
+		return nil
+	}
 	return imgLoadAPNGAnimationIO(src)
 }
 
@@ -639,6 +723,10 @@ func LoadAPNGAnimationIO(src *sdl.IOStream) *Animation {
 //
 // [LoadAVIFAnimationIO]: https://wiki.libsdl.org/SDL3_image/IMG_LoadAVIFAnimation_IO
 func LoadAVIFAnimationIO(src *sdl.IOStream) *Animation {
+	if imgLoadAVIFAnimationIO == nil {
+		notAvailable("IMG_LoadAVIFAnimation_IO")
+		return nil
+	}
 	return imgLoadAVIFAnimationIO(src)
 }
 
@@ -648,6 +736,10 @@ func LoadAVIFAnimationIO(src *sdl.IOStream) *Animation {
 //
 // [SaveAnimation]: https://wiki.libsdl.org/SDL3_image/IMG_SaveAnimation
 func SaveAnimation(anim *Animation, file string) bool {
+	if imgSaveAnimation == nil {
+		notAvailable("IMG_SaveAnimation")
+		return false
+	}
 	return imgSaveAnimation(anim, file)
 }
 
@@ -657,6 +749,10 @@ func SaveAnimation(anim *Animation, file string) bool {
 //
 // [SaveAnimationTypedIO]: https://wiki.libsdl.org/SDL3_image/IMG_SaveAnimationTyped_IO
 func SaveAnimationTypedIO(anim *Animation, dst *sdl.IOStream, closeio bool, _type string) bool {
+	if imgSaveAnimationTypedIO == nil {
+		notAvailable("IMG_SaveAnimationTyped_IO")
+		return false
+	}
 	return imgSaveAnimationTypedIO(anim, dst, closeio, _type)
 }
 
@@ -666,6 +762,10 @@ func SaveAnimationTypedIO(anim *Animation, dst *sdl.IOStream, closeio bool, _typ
 //
 // [SaveANIAnimationIO]: https://wiki.libsdl.org/SDL3_image/IMG_SaveANIAnimation_IO
 func SaveANIAnimationIO(anim *Animation, dst *sdl.IOStream, closeio bool) bool {
+	if imgSaveANIAnimationIO == nil {
+		notAvailable("IMG_SaveANIAnimation_IO")
+		return false
+	}
 	return imgSaveANIAnimationIO(anim, dst, closeio)
 }
 
@@ -675,6 +775,10 @@ func SaveANIAnimationIO(anim *Animation, dst *sdl.IOStream, closeio bool) bool {
 //
 // [SaveAPNGAnimationIO]: https://wiki.libsdl.org/SDL3_image/IMG_SaveAPNGAnimation_IO
 func SaveAPNGAnimationIO(anim *Animation, dst *sdl.IOStream, closeio bool) bool {
+	if imgSaveAPNGAnimationIO == nil {
+		notAvailable("IMG_SaveAPNGAnimation_IO")
+		return false
+	}
 	return imgSaveAPNGAnimationIO(anim, dst, closeio)
 }
 
@@ -684,6 +788,10 @@ func SaveAPNGAnimationIO(anim *Animation, dst *sdl.IOStream, closeio bool) bool
 //
 // [SaveAVIFAnimationIO]: https://wiki.libsdl.org/SDL3_image/IMG_SaveAVIFAnimation_IO
 func SaveAVIFAnimationIO(anim *Animation, dst *sdl.IOStream, closeio bool, quality int32) bool {
+	if imgSaveAVIFAnimationIO == nil {
+		notAvailable("IMG_SaveAVIFAnimation_IO")
+		return false
+	}
 	return imgSaveAVIFAnimationIO(anim, dst, closeio, quality)
 }
 
@@ -693,6 +801,10 @@ func SaveAVIFAnimationIO(anim *Animation, dst *sdl.IOStream, closeio bool, quali
 //
 // [SaveGIFAnimationIO]: https://wiki.libsdl.org/SDL3_image/IMG_SaveGIFAnimation_IO
 func SaveGIFAnimationIO(anim *Animation, dst *sdl.IOStream, closeio bool) bool {
+	if imgSaveGIFAnimationIO == nil {
+		notAvailable("IMG_SaveGIFAnimation_IO")
+		return false
+	}
 	return imgSaveGIFAnimationIO(anim, dst, closeio)
 }
 
@@ -702,6 +814,10 @@ func SaveGIFAnimationIO(anim *Animation, dst *sdl.IOStream, closeio bool) bool {
 //
 // [SaveWEBPAnimationIO]: https://wiki.libsdl.org/SDL3_image/IMG_SaveWEBPAnimation_IO
 func SaveWEBPAnimationIO(anim *Animation, dst *sdl.IOStream, closeio bool, quality int32) bool {
+	if imgSaveWEBPAnimationIO == nil {
+		notAvailable("IMG_SaveWEBPAnimation_IO")
+		return false
+	}
 	return imgSaveWEBPAnimationIO(anim, dst, closeio, quality)
 }
 
@@ -711,6 +827,10 @@ func SaveWEBPAnimationIO(anim *Animation, dst *sdl.IOStream, closeio bool, quali
 //
 // [CreateAnimatedCursor]: https://wiki.libsdl.org/SDL3_image/IMG_CreateAnimatedCursor
 func CreateAnimatedCursor(anim *Animation, hotX, hotY int32) *sdl.Cursor {
+	if imgCreateAnimatedCursor == nil {
+		notAvailable("IMG_CreateAnimatedCursor")
+		return nil
+	}
 	return imgCreateAnimatedCursor(anim, hotX, hotY)
 }
 
@@ -727,6 +847,10 @@ type AnimationEncoder struct{}
 //
 // [CreateAnimationEncoder]: https://wiki.libsdl.org/SDL3_image/IMG_CreateAnimationEncoder
 func CreateAnimationEncoder(file string) *AnimationEncoder {
+	if imgCreateAnimationEncoder == nil {
+		notAvailable("IMG_CreateAnimationEncoder")
+		return nil
+	}
 	return imgCreateAnimationEncoder(file)
 }
 
@@ -736,6 +860,10 @@ func CreateAnimationEncoder(file string) *AnimationEncoder {
 //
 // [CreateAnimationEncoderIO]: https://wiki.libsdl.org/SDL3_image/IMG_CreateAnimationEncoder_IO
 func CreateAnimationEncoderIO(dst *sdl.IOStream, closeio bool, _type string) *AnimationEncoder {
+	if imgCreateAnimationEncoderIO == nil {
+		notAvailable("IMG_CreateAnimationEncoder_IO")
+		return nil
+	}
 	return imgCreateAnimationEncoderIO(dst, closeio, _type)
 }
 
@@ -745,6 +873,10 @@ func CreateAnimationEncoderIO(dst *sdl.IOStream, closeio bool, _type string) *An
 //
 // [CreateAnimationEncoderWithProperties]: https://wiki.libsdl.org/SDL3_image/IMG_CreateAnimationEncoderWithProperties
 func CreateAnimationEncoderWithProperties(props sdl.PropertiesID) *AnimationEncoder {
+	if imgCreateAnimationEncoderWithProperties == nil {
+		notAvailable("IMG_CreateAnimationEncoderWithProperties")
+		return nil
+	}
 	return imgCreateAnimationEncoderWithProperties(props)
 }
 
@@ -768,6 +900,10 @@ const (
 //
 // [AddAnimationEncoderFrame]: https://wiki.libsdl.org/SDL3_image/IMG_AddAnimationEncoderFrame
 func AddAnimationEncoderFrame(encoder *AnimationEncoder, surface *sdl.Surface, duration uint64) bool {
+	if imgAddAnimationEncoderFrame == nil {
+		notAvailable("IMG_AddAnimationEncoderFrame")
+		return false
+	}
 	return imgAddAnimationEncoderFrame(encoder, surface, duration)
 }
 
@@ -777,6 +913,10 @@ func AddAnimationEncoderFrame(encoder *AnimationEncoder, surface *sdl.Surface, d
 //
 // [CloseAnimationEncoder]: https://wiki.libsdl.org/SDL3_image/IMG_CloseAnimationEncoder
 func CloseAnimationEncoder(encoder *AnimationEncoder) bool {
+	if imgCloseAnimationEncoder == nil {
+		notAvailable("IMG_CloseAnimationEncoder")
+		return false
+	}
 	return imgCloseAnimationEncoder(encoder)
 }
 
@@ -807,6 +947,10 @@ type AnimationDecoder struct{}
 //
 // [CreateAnimationDecoder]: https://wiki.libsdl.org/SDL3_image/IMG_CreateAnimationDecoder
 func CreateAnimationDecoder(file string) *AnimationDecoder {
+	if imgCreateAnimationDecoder == nil {
+		notAvailable("IMG_CreateAnimationDecoder")
+		return nil
+	}
 	return imgCreateAnimationDecoder(file)
 }
 
@@ -816,6 +960,10 @@ func CreateAnimationDecoder(file string) *AnimationDecoder {
 //
 // [CreateAnimationDecoderIO]: https://wiki.libsdl.org/SDL3_image/IMG_CreateAnimationDecoder_IO
 func CreateAnimationDecoderIO(src *sdl.IOStream, closeio bool, _type string) *AnimationDecoder {
+	if imgCreateAnimationDecoderIO == nil {
+		notAvailable("IMG_CreateAnimationDecoder_IO")
+		return nil
+	}
 	return imgCreateAnimationDecoderIO(src, closeio, _type)
 }
 
@@ -825,6 +973,10 @@ func CreateAnimationDecoderIO(src *sdl.IOStream, closeio bool, _type string) *An
 //
 // [CreateAnimationDecoderWithProperties]: https://wiki.libsdl.org/SDL3_image/IMG_CreateAnimationDecoderWithProperties
 func CreateAnimationDecoderWithProperties(props sdl.PropertiesID) *AnimationDecoder {
+	if imgCreateAnimationDecoderWithProperties == nil {
+		notAvailable("IMG_CreateAnimationDecoderWithProperties")
+		return nil
+	}
 	return imgCreateAnimationDecoderWithProperties(props)
 }
 
@@ -849,6 +1001,10 @@ const (
 //
 // [GetAnimationDecoderProperties]: https://wiki.libsdl.org/SDL3_image/IMG_GetAnimationDecoderProperties
 func GetAnimationDecoderProperties(decoder *AnimationDecoder) sdl.PropertiesID {
+	if imgGetAnimationDecoderProperties == nil {
+		notAvailable("IMG_GetAnimationDecoderProperties")
+		return 0
+	}
 	return imgGetAnimationDecoderProperties(decoder)
 }
 
@@ -869,6 +1025,10 @@ const (
 //
 // [GetAnimationDecoderFrame]: https://wiki.libsdl.org/SDL3_image/IMG_GetAnimationDecoderFrame
 func GetAnimationDecoderFrame(decoder *AnimationDecoder, frame **sdl.Surface, duration *uint64) bool {
+	if imgGetAnimationDecoderFrame == nil {
+		notAvailable("IMG_GetAnimationDecoderFrame")
+		return false
+	}
 	return imgGetAnimationDecoderFrame(decoder, frame, duration)
 }
 
@@ -878,6 +1038,10 @@ func GetAnimationDecoderFrame(decoder *AnimationDecoder, frame **sdl.Surface, du
 //
 // [GetAnimationDecoderStatus]: https://wiki.libsdl.org/SDL3_image/IMG_GetAnimationDecoderStatus
 func GetAnimationDecoderStatus(decoder *AnimationDecoder) AnimationDecoderStatus {
+	if imgGetAnimationDecoderStatus == nil {
+		notAvailable("IMG_GetAnimationDecoderStatus")
+		return DecoderStatusInvalid
+	}
 	return imgGetAnimationDecoderStatus(decoder)
 }
 
@@ -887,6 +1051,10 @@ func GetAnimationDecoderStatus(decoder *AnimationDecoder) AnimationDecoderStatus
 //
 // [ResetAnimationDecoder]: https://wiki.libsdl.org/SDL3_image/IMG_ResetAnimationDecoder
 func ResetAnimationDecoder(decoder *AnimationDecoder) bool {
+	if imgResetAnimationDecoder == nil {
+		notAvailable("IMG_ResetAnimationDecoder")
+		return false
+	}
 	return imgResetAnimationDecoder(decoder)
 }
 
@@ -896,5 +1064,9 @@ func ResetAnimationDecoder(decoder *AnimationDecoder) bool {
 //
 // [CloseAnimationDecoder]: https://wiki.libsdl.org/SDL3_image/IMG_CloseAnimationDecoder
 func CloseAnimationDecoder(decoder *AnimationDecoder) bool {
+	if imgCloseAnimationDecoder == nil {
+		notAvailable("IMG_CloseAnimationDecoder")
+		return false
+	}
 	return imgCloseAnimationDecoder(decoder)
 }
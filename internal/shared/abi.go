@@ -0,0 +1,84 @@
+package shared
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+	"unsafe"
+)
+
+// Layout is the layout of a C struct as measured from the headers, together with the Go type mirroring it.
+type Layout struct {
+	Name   string       // The C name, e.g. "SDL_AudioSpec".
+	Type   reflect.Type // The Go type.
+	Size   uintptr      // The size of the C type.
+	Align  uintptr      // The alignment of the C type.
+	Union  bool         // Unions are mirrored by byte arrays, so only their size is compared.
+	Fields []Field      // The fields to compare.
+}
+
+// Field is a field of a C struct.
+type Field struct {
+	Name   string  // The C name, e.g. "mip_level".
+	Go     string  // The name of the Go field. An unexported field may start with a lower-case letter instead.
+	Offset uintptr // The offset in the C struct.
+}
+
+// VerifyLayouts compares the Go types with the C layouts, which were measured on a platform with pointerSize,
+// and returns a description of every difference. It reports false without comparing anything, if this platform
+// uses pointers of another size, because the layouts don't apply to it.
+func VerifyLayouts(layouts []Layout, pointerSize uintptr) (mismatches []string, measured bool) {
+	if unsafe.Sizeof(uintptr(0)) != pointerSize {
+		return nil, false
+	}
+
+	for _, l := range layouts {
+		report := func(format string, args ...interface{}) {
+			mismatches = append(mismatches, fmt.Sprintf("%s (%s): ", l.Name, l.Type)+fmt.Sprintf(format, args...))
+		}
+
+		if size := l.Type.Size(); size != l.Size {
+			report("size is %d, want %d", size, l.Size)
+		}
+		if l.Union {
+			continue
+		}
+		if align := uintptr(l.Type.Align()); align != l.Align {
+			report("alignment is %d, want %d", align, l.Align)
+		}
+		for _, f := range l.Fields {
+			offset, ok := fieldOffset(l.Type, f.Go)
+			if !ok {
+				report("field %s has no counterpart %s", f.Name, f.Go)
+			} else if offset != f.Offset {
+				report("field %s is at offset %d, want %d", f.Name, offset, f.Offset)
+			}
+		}
+	}
+	return mismatches, true
+}
+
+// fieldOffset returns the offset of the field name in the struct t, which may be promoted from an embedded struct.
+func fieldOffset(t reflect.Type, name string) (uintptr, bool) {
+	if t.Kind() != reflect.Struct {
+		return 0, false
+	}
+	field, ok := t.FieldByName(name)
+	if !ok {
+		r, size := utf8.DecodeRuneInString(name)
+		field, ok = t.FieldByName(string(unicode.ToLower(r)) + name[size:])
+	}
+	if !ok || strings.HasPrefix(field.Name, "_") {
+		return 0, false
+	}
+
+	var offset uintptr
+	for _, i := range field.Index {
+		f := t.Field(i)
+		offset += f.Offset
+		t = f.Type
+	}
+	return offset, true
+}
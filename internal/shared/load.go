@@ -5,18 +5,26 @@ package shared
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 
 	"github.com/ebitengine/purego"
 )
 
 func Load(name string) (uintptr, error) {
-	localName := fmt.Sprintf(".%s%s", string(os.PathSeparator), name)
-	if p, err := purego.Dlopen(localName, purego.RTLD_LAZY); err == nil {
-		return p, nil
+	if filepath.Base(name) == name {
+		localName := fmt.Sprintf(".%s%s", string(os.PathSeparator), name)
+		if p, err := purego.Dlopen(localName, purego.RTLD_LAZY); err == nil {
+			return p, nil
+		}
 	}
 	return purego.Dlopen(name, purego.RTLD_LAZY)
 }
 
+// Close decrements the reference count of lib and unloads it, once it drops to zero.
+func Close(lib uintptr) error {
+	return purego.Dlclose(lib)
+}
+
 func Get(lib uintptr, name string) uintptr {
 	addr, err := purego.Dlsym(lib, name)
 	if err != nil {
@@ -24,3 +32,9 @@ func Get(lib uintptr, name string) uintptr {
 	}
 	return addr
 }
+
+// Lookup returns the address of the symbol name in lib and whether it exists.
+func Lookup(lib uintptr, name string) (uintptr, bool) {
+	addr, err := purego.Dlsym(lib, name)
+	return addr, err == nil && addr != 0
+}
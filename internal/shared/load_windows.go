@@ -13,6 +13,11 @@ func Load(name string) (uintptr, error) {
 	return uintptr(handle), err
 }
 
+// Close decrements the reference count of lib and unloads it, once it drops to zero.
+func Close(lib uintptr) error {
+	return syscall.FreeLibrary(syscall.Handle(lib))
+}
+
 func Get(lib uintptr, name string) uintptr {
 	addr, err := syscall.GetProcAddress(syscall.Handle(lib), name)
 	if err != nil {
@@ -20,3 +25,9 @@ func Get(lib uintptr, name string) uintptr {
 	}
 	return addr
 }
+
+// Lookup returns the address of the symbol name in lib and whether it exists.
+func Lookup(lib uintptr, name string) (uintptr, bool) {
+	addr, err := syscall.GetProcAddress(syscall.Handle(lib), name)
+	return addr, err == nil && addr != 0
+}
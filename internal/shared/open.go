@@ -0,0 +1,41 @@
+package shared
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// EnvSearchPath names the environment variable holding a list of directories, separated by [os.PathListSeparator],
+// that are searched for all libraries before the default locations.
+const EnvSearchPath = "PUREGO_SDL3_PATH"
+
+// Open loads the library name. If the environment variable envFile is set, its value is used as the path of the
+// library file. Otherwise the directories listed in [EnvSearchPath] are tried first, followed by [Load] with name.
+func Open(name, envFile string) (uintptr, error) {
+	if path := os.Getenv(envFile); path != "" {
+		return Load(path)
+	}
+
+	for _, dir := range filepath.SplitList(os.Getenv(EnvSearchPath)) {
+		if dir == "" {
+			continue
+		}
+		if lib, err := Load(filepath.Join(dir, name)); err == nil {
+			return lib, nil
+		}
+	}
+
+	return Load(name)
+}
+
+// Bind calls register with lib and converts a panic caused by a missing symbol into an error.
+func Bind(lib uintptr, register func(lib uintptr)) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("binding functions: %v", r)
+		}
+	}()
+	register(lib)
+	return nil
+}
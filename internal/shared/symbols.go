@@ -0,0 +1,85 @@
+package shared
+
+import (
+	"reflect"
+	"sync"
+
+	"github.com/ebitengine/purego"
+)
+
+// symbol records whether an optional function was found and in which library it was looked up.
+type symbol struct {
+	lib   uintptr
+	found bool
+}
+
+var symbols = struct {
+	sync.RWMutex
+	found map[string]symbol
+}{found: make(map[string]symbol)}
+
+// Register looks up the optional function name in lib and records whether it exists.
+//
+// If it exists, fptr is bound to it. fptr is either a pointer to a function variable, as accepted by
+// [purego.RegisterFunc], or a pointer to an uintptr receiving the address. Otherwise fptr is reset to its zero value,
+// so that wrappers can detect the missing function.
+func Register(fptr interface{}, lib uintptr, name string) bool {
+	addr, ok := Lookup(lib, name)
+
+	symbols.Lock()
+	symbols.found[name] = symbol{lib: lib, found: ok}
+	symbols.Unlock()
+
+	switch {
+	case !ok:
+		v := reflect.ValueOf(fptr).Elem()
+		v.Set(reflect.Zero(v.Type()))
+	case reflect.TypeOf(fptr).Elem().Kind() == reflect.Uintptr:
+		*fptr.(*uintptr) = addr
+	default:
+		purego.RegisterFunc(fptr, addr)
+	}
+	return ok
+}
+
+// Has reports whether the function name exists in lib. Results recorded by [Register] are used if available.
+func Has(lib uintptr, name string) bool {
+	symbols.RLock()
+	sym, ok := symbols.found[name]
+	symbols.RUnlock()
+	if ok && sym.lib == lib {
+		return sym.found
+	}
+
+	if lib == 0 {
+		return false
+	}
+	_, found := Lookup(lib, name)
+	return found
+}
+
+// Missing reports whether the function name was probed by [Register] and not found.
+func Missing(name string) bool {
+	symbols.RLock()
+	defer symbols.RUnlock()
+	sym, ok := symbols.found[name]
+	return ok && !sym.found
+}
+
+// Replace closes the library old after lib was bound in its place and forgets the results of [Register] for old.
+// Opening the same file again returns the same handle with an increased reference count, which is just decremented.
+func Replace(old, lib uintptr) {
+	if old == 0 {
+		return
+	}
+	if old != lib {
+		symbols.Lock()
+		for name, sym := range symbols.found {
+			if sym.lib == old {
+				delete(symbols.found, name)
+			}
+		}
+		symbols.Unlock()
+	}
+	_ = Close(old)
+}
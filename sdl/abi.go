@@ -0,0 +1,38 @@
+package sdl
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/jupiterrider/purego-sdl3/internal/shared"
+)
+
+// ErrABINotMeasured is returned by [VerifyABI] on platforms with another pointer size than the one the reference table
+// was measured on, e.g. 32-bit platforms. The Go types can't be verified there.
+var ErrABINotMeasured = errors.New("sdl: the C struct layouts were not measured for this platform")
+
+// ABIError lists the Go types whose layout differs from the C structs they mirror.
+type ABIError struct {
+	Mismatches []string
+}
+
+func (e *ABIError) Error() string {
+	return "sdl: Go types don't match the C ABI:\n\t" + strings.Join(e.Mismatches, "\n\t")
+}
+
+// VerifyABI checks the size, alignment and field offsets of the Go types mirroring C structs, like [Event], [Surface]
+// or [GPUColorTargetInfo], against a reference table measured from the SDL headers. It returns an [*ABIError]
+// describing every difference, which would make SDL read or write the wrong memory, or [ErrABINotMeasured].
+//
+// The table is regenerated with cmd/bindgen for new SDL releases. VerifyABI doesn't need the SDL library,
+// so it can also be called from tests.
+func VerifyABI() error {
+	mismatches, measured := shared.VerifyLayouts(abiLayouts, abiPointerSize)
+	switch {
+	case !measured:
+		return ErrABINotMeasured
+	case len(mismatches) > 0:
+		return &ABIError{Mismatches: mismatches}
+	}
+	return nil
+}
@@ -0,0 +1,1332 @@
+// Code generated by bindgen from sdl3.json. DO NOT EDIT.
+
+package sdl
+
+import (
+	"reflect"
+
+	"github.com/jupiterrider/purego-sdl3/internal/shared"
+)
+
+// abiPointerSize is the size of pointers on the platform the layouts were measured on.
+const abiPointerSize = 8
+
+// abiLayouts are the layouts of the C structs mirrored by Go types, as measured from the SDL3 headers.
+var abiLayouts = []shared.Layout{
+	{
+		Name: "SDL_AsyncIOOutcome", Type: reflect.TypeOf(AsyncIOOutcome{}), Size: 56, Align: 8,
+		Fields: []shared.Field{
+			{Name: "asyncio", Go: "AsyncIO", Offset: 0},
+			{Name: "type", Go: "Type", Offset: 8},
+			{Name: "result", Go: "Result", Offset: 12},
+			{Name: "buffer", Go: "Buffer", Offset: 16},
+			{Name: "offset", Go: "Offset", Offset: 24},
+			{Name: "bytes_requested", Go: "BytesRequested", Offset: 32},
+			{Name: "bytes_transferred", Go: "BytesTransferred", Offset: 40},
+			{Name: "userdata", Go: "Userdata", Offset: 48},
+		},
+	},
+	{
+		Name: "SDL_AtomicInt", Type: reflect.TypeOf(AtomicInt{}), Size: 4, Align: 4,
+		Fields: []shared.Field{
+			{Name: "value", Go: "Value", Offset: 0},
+		},
+	},
+	{
+		Name: "SDL_AtomicU32", Type: reflect.TypeOf(AtomicU32{}), Size: 4, Align: 4,
+		Fields: []shared.Field{
+			{Name: "value", Go: "Value", Offset: 0},
+		},
+	},
+	{
+		Name: "SDL_AudioDeviceEvent", Type: reflect.TypeOf(AudioDeviceEvent{}), Size: 24, Align: 8,
+		Fields: []shared.Field{
+			{Name: "type", Go: "Type", Offset: 0},
+			{Name: "reserved", Go: "Reserved", Offset: 4},
+			{Name: "timestamp", Go: "Timestamp", Offset: 8},
+			{Name: "which", Go: "Which", Offset: 16},
+			{Name: "recording", Go: "Recording", Offset: 20},
+		},
+	},
+	{
+		Name: "SDL_AudioSpec", Type: reflect.TypeOf(AudioSpec{}), Size: 12, Align: 4,
+		Fields: []shared.Field{
+			{Name: "format", Go: "Format", Offset: 0},
+			{Name: "channels", Go: "Channels", Offset: 4},
+			{Name: "freq", Go: "Freq", Offset: 8},
+		},
+	},
+	{
+		Name: "SDL_CameraDeviceEvent", Type: reflect.TypeOf(CameraDeviceEvent{}), Size: 24, Align: 8,
+		Fields: []shared.Field{
+			{Name: "type", Go: "Type", Offset: 0},
+			{Name: "reserved", Go: "Reserved", Offset: 4},
+			{Name: "timestamp", Go: "Timestamp", Offset: 8},
+			{Name: "which", Go: "Which", Offset: 16},
+		},
+	},
+	{
+		Name: "SDL_CameraSpec", Type: reflect.TypeOf(CameraSpec{}), Size: 24, Align: 4,
+		Fields: []shared.Field{
+			{Name: "format", Go: "Format", Offset: 0},
+			{Name: "colorspace", Go: "Colorspace", Offset: 4},
+			{Name: "width", Go: "Width", Offset: 8},
+			{Name: "height", Go: "Height", Offset: 12},
+			{Name: "framerate_numerator", Go: "FramerateNumerator", Offset: 16},
+			{Name: "framerate_denominator", Go: "FramerateDenominator", Offset: 20},
+		},
+	},
+	{
+		Name: "SDL_ClipboardEvent", Type: reflect.TypeOf(ClipboardEvent{}), Size: 32, Align: 8,
+		Fields: []shared.Field{
+			{Name: "type", Go: "Type", Offset: 0},
+			{Name: "reserved", Go: "Reserved", Offset: 4},
+			{Name: "timestamp", Go: "Timestamp", Offset: 8},
+			{Name: "owner", Go: "Owner", Offset: 16},
+			{Name: "num_mime_types", Go: "NumMimeTypes", Offset: 20},
+			{Name: "mime_types", Go: "MimeTypes", Offset: 24},
+		},
+	},
+	{
+		Name: "SDL_Color", Type: reflect.TypeOf(Color{}), Size: 4, Align: 1,
+		Fields: []shared.Field{
+			{Name: "r", Go: "R", Offset: 0},
+			{Name: "g", Go: "G", Offset: 1},
+			{Name: "b", Go: "B", Offset: 2},
+			{Name: "a", Go: "A", Offset: 3},
+		},
+	},
+	{
+		Name: "SDL_CommonEvent", Type: reflect.TypeOf(CommonEvent{}), Size: 16, Align: 8,
+		Fields: []shared.Field{
+			{Name: "type", Go: "Type", Offset: 0},
+			{Name: "reserved", Go: "Reserved", Offset: 4},
+			{Name: "timestamp", Go: "Timestamp", Offset: 8},
+		},
+	},
+	{
+		Name: "SDL_CursorFrameInfo", Type: reflect.TypeOf(CursorFrameInfo{}), Size: 16, Align: 8,
+		Fields: []shared.Field{
+			{Name: "surface", Go: "Surface", Offset: 0},
+			{Name: "duration", Go: "Duration", Offset: 8},
+		},
+	},
+	{
+		Name: "SDL_DateTime", Type: reflect.TypeOf(DateTime{}), Size: 36, Align: 4,
+		Fields: []shared.Field{
+			{Name: "year", Go: "Year", Offset: 0},
+			{Name: "month", Go: "Month", Offset: 4},
+			{Name: "day", Go: "Day", Offset: 8},
+			{Name: "hour", Go: "Hour", Offset: 12},
+			{Name: "minute", Go: "Minute", Offset: 16},
+			{Name: "second", Go: "Second", Offset: 20},
+			{Name: "nanosecond", Go: "Nanosecond", Offset: 24},
+			{Name: "day_of_week", Go: "DayOfWeek", Offset: 28},
+			{Name: "utc_offset", Go: "UtcOffset", Offset: 32},
+		},
+	},
+	{
+		Name: "SDL_DialogFileFilter", Type: reflect.TypeOf(DialogFileFilter{}), Size: 16, Align: 8,
+		Fields: []shared.Field{
+			{Name: "name", Go: "Name", Offset: 0},
+			{Name: "pattern", Go: "Pattern", Offset: 8},
+		},
+	},
+	{
+		Name: "SDL_DisplayEvent", Type: reflect.TypeOf(DisplayEvent{}), Size: 32, Align: 8,
+		Fields: []shared.Field{
+			{Name: "type", Go: "Type", Offset: 0},
+			{Name: "reserved", Go: "Reserved", Offset: 4},
+			{Name: "timestamp", Go: "Timestamp", Offset: 8},
+			{Name: "displayID", Go: "DisplayID", Offset: 16},
+			{Name: "data1", Go: "Data1", Offset: 20},
+			{Name: "data2", Go: "Data2", Offset: 24},
+		},
+	},
+	{
+		Name: "SDL_DisplayMode", Type: reflect.TypeOf(DisplayMode{}), Size: 40, Align: 8,
+		Fields: []shared.Field{
+			{Name: "displayID", Go: "DisplayID", Offset: 0},
+			{Name: "format", Go: "Format", Offset: 4},
+			{Name: "w", Go: "W", Offset: 8},
+			{Name: "h", Go: "H", Offset: 12},
+			{Name: "pixel_density", Go: "PixelDensity", Offset: 16},
+			{Name: "refresh_rate", Go: "RefreshRate", Offset: 20},
+			{Name: "refresh_rate_numerator", Go: "RefreshRateNumerator", Offset: 24},
+			{Name: "refresh_rate_denominator", Go: "RefreshRateDenominator", Offset: 28},
+			{Name: "internal", Go: "Internal", Offset: 32},
+		},
+	},
+	{
+		Name: "SDL_DropEvent", Type: reflect.TypeOf(DropEvent{}), Size: 48, Align: 8,
+		Fields: []shared.Field{
+			{Name: "type", Go: "Type", Offset: 0},
+			{Name: "reserved", Go: "Reserved", Offset: 4},
+			{Name: "timestamp", Go: "Timestamp", Offset: 8},
+			{Name: "windowID", Go: "WindowID", Offset: 16},
+			{Name: "x", Go: "X", Offset: 20},
+			{Name: "y", Go: "Y", Offset: 24},
+			{Name: "source", Go: "Source", Offset: 32},
+			{Name: "data", Go: "Data", Offset: 40},
+		},
+	},
+	{
+		Name: "SDL_Event", Type: reflect.TypeOf(Event{}), Size: 128, Align: 8,
+		Union: true,
+	},
+	{
+		Name: "SDL_FColor", Type: reflect.TypeOf(FColor{}), Size: 16, Align: 4,
+		Fields: []shared.Field{
+			{Name: "r", Go: "R", Offset: 0},
+			{Name: "g", Go: "G", Offset: 4},
+			{Name: "b", Go: "B", Offset: 8},
+			{Name: "a", Go: "A", Offset: 12},
+		},
+	},
+	{
+		Name: "SDL_Finger", Type: reflect.TypeOf(Finger{}), Size: 24, Align: 8,
+		Fields: []shared.Field{
+			{Name: "id", Go: "ID", Offset: 0},
+			{Name: "x", Go: "X", Offset: 8},
+			{Name: "y", Go: "Y", Offset: 12},
+			{Name: "pressure", Go: "Pressure", Offset: 16},
+		},
+	},
+	{
+		Name: "SDL_FPoint", Type: reflect.TypeOf(FPoint{}), Size: 8, Align: 4,
+		Fields: []shared.Field{
+			{Name: "x", Go: "X", Offset: 0},
+			{Name: "y", Go: "Y", Offset: 4},
+		},
+	},
+	{
+		Name: "SDL_FRect", Type: reflect.TypeOf(FRect{}), Size: 16, Align: 4,
+		Fields: []shared.Field{
+			{Name: "x", Go: "X", Offset: 0},
+			{Name: "y", Go: "Y", Offset: 4},
+			{Name: "w", Go: "W", Offset: 8},
+			{Name: "h", Go: "H", Offset: 12},
+		},
+	},
+	{
+		Name: "SDL_GamepadAxisEvent", Type: reflect.TypeOf(GamepadAxisEvent{}), Size: 32, Align: 8,
+		Fields: []shared.Field{
+			{Name: "type", Go: "Type", Offset: 0},
+			{Name: "reserved", Go: "Reserved", Offset: 4},
+			{Name: "timestamp", Go: "Timestamp", Offset: 8},
+			{Name: "which", Go: "Which", Offset: 16},
+			{Name: "axis", Go: "Axis", Offset: 20},
+			{Name: "value", Go: "Value", Offset: 24},
+		},
+	},
+	{
+		Name: "SDL_GamepadBinding", Type: reflect.TypeOf(GamepadBinding{}), Size: 32, Align: 4,
+		Fields: []shared.Field{
+			{Name: "input_type", Go: "InputType", Offset: 0},
+			{Name: "input", Go: "Input", Offset: 4},
+			{Name: "output_type", Go: "OutputType", Offset: 16},
+			{Name: "output", Go: "Output", Offset: 20},
+		},
+	},
+	{
+		Name: "SDL_GamepadButtonEvent", Type: reflect.TypeOf(GamepadButtonEvent{}), Size: 24, Align: 8,
+		Fields: []shared.Field{
+			{Name: "type", Go: "Type", Offset: 0},
+			{Name: "reserved", Go: "Reserved", Offset: 4},
+			{Name: "timestamp", Go: "Timestamp", Offset: 8},
+			{Name: "which", Go: "Which", Offset: 16},
+			{Name: "button", Go: "Button", Offset: 20},
+			{Name: "down", Go: "Down", Offset: 21},
+		},
+	},
+	{
+		Name: "SDL_GamepadDeviceEvent", Type: reflect.TypeOf(GamepadDeviceEvent{}), Size: 24, Align: 8,
+		Fields: []shared.Field{
+			{Name: "type", Go: "Type", Offset: 0},
+			{Name: "reserved", Go: "Reserved", Offset: 4},
+			{Name: "timestamp", Go: "Timestamp", Offset: 8},
+			{Name: "which", Go: "Which", Offset: 16},
+		},
+	},
+	{
+		Name: "SDL_GamepadSensorEvent", Type: reflect.TypeOf(GamepadSensorEvent{}), Size: 48, Align: 8,
+		Fields: []shared.Field{
+			{Name: "type", Go: "Type", Offset: 0},
+			{Name: "reserved", Go: "Reserved", Offset: 4},
+			{Name: "timestamp", Go: "Timestamp", Offset: 8},
+			{Name: "which", Go: "Which", Offset: 16},
+			{Name: "sensor", Go: "Sensor", Offset: 20},
+			{Name: "data", Go: "Data", Offset: 24},
+			{Name: "sensor_timestamp", Go: "SensorTimestamp", Offset: 40},
+		},
+	},
+	{
+		Name: "SDL_GamepadTouchpadEvent", Type: reflect.TypeOf(GamepadTouchpadEvent{}), Size: 40, Align: 8,
+		Fields: []shared.Field{
+			{Name: "type", Go: "Type", Offset: 0},
+			{Name: "reserved", Go: "Reserved", Offset: 4},
+			{Name: "timestamp", Go: "Timestamp", Offset: 8},
+			{Name: "which", Go: "Which", Offset: 16},
+			{Name: "touchpad", Go: "Touchpad", Offset: 20},
+			{Name: "finger", Go: "Finger", Offset: 24},
+			{Name: "x", Go: "X", Offset: 28},
+			{Name: "y", Go: "Y", Offset: 32},
+			{Name: "pressure", Go: "Pressure", Offset: 36},
+		},
+	},
+	{
+		Name: "SDL_GPUBlitInfo", Type: reflect.TypeOf(GPUBlitInfo{}), Size: 96, Align: 8,
+		Fields: []shared.Field{
+			{Name: "source", Go: "Source", Offset: 0},
+			{Name: "destination", Go: "Destination", Offset: 32},
+			{Name: "load_op", Go: "LoadOp", Offset: 64},
+			{Name: "clear_color", Go: "ClearColor", Offset: 68},
+			{Name: "flip_mode", Go: "FlipMode", Offset: 84},
+			{Name: "filter", Go: "Filter", Offset: 88},
+			{Name: "cycle", Go: "Cycle", Offset: 92},
+		},
+	},
+	{
+		Name: "SDL_GPUBlitRegion", Type: reflect.TypeOf(GPUBlitRegion{}), Size: 32, Align: 8,
+		Fields: []shared.Field{
+			{Name: "texture", Go: "Texture", Offset: 0},
+			{Name: "mip_level", Go: "MipLevel", Offset: 8},
+			{Name: "layer_or_depth_plane", Go: "LayerOrDepthPlane", Offset: 12},
+			{Name: "x", Go: "X", Offset: 16},
+			{Name: "y", Go: "Y", Offset: 20},
+			{Name: "w", Go: "W", Offset: 24},
+			{Name: "h", Go: "H", Offset: 28},
+		},
+	},
+	{
+		Name: "SDL_GPUBufferBinding", Type: reflect.TypeOf(GPUBufferBinding{}), Size: 16, Align: 8,
+		Fields: []shared.Field{
+			{Name: "buffer", Go: "Buffer", Offset: 0},
+			{Name: "offset", Go: "Offset", Offset: 8},
+		},
+	},
+	{
+		Name: "SDL_GPUBufferCreateInfo", Type: reflect.TypeOf(GPUBufferCreateInfo{}), Size: 12, Align: 4,
+		Fields: []shared.Field{
+			{Name: "usage", Go: "Usage", Offset: 0},
+			{Name: "size", Go: "Size", Offset: 4},
+			{Name: "props", Go: "Props", Offset: 8},
+		},
+	},
+	{
+		Name: "SDL_GPUBufferLocation", Type: reflect.TypeOf(GPUBufferLocation{}), Size: 16, Align: 8,
+		Fields: []shared.Field{
+			{Name: "buffer", Go: "Buffer", Offset: 0},
+			{Name: "offset", Go: "Offset", Offset: 8},
+		},
+	},
+	{
+		Name: "SDL_GPUBufferRegion", Type: reflect.TypeOf(GPUBufferRegion{}), Size: 16, Align: 8,
+		Fields: []shared.Field{
+			{Name: "buffer", Go: "Buffer", Offset: 0},
+			{Name: "offset", Go: "Offset", Offset: 8},
+			{Name: "size", Go: "Size", Offset: 12},
+		},
+	},
+	{
+		Name: "SDL_GPUColorTargetBlendState", Type: reflect.TypeOf(GPUColorTargetBlendState{}), Size: 32, Align: 4,
+		Fields: []shared.Field{
+			{Name: "src_color_blendfactor", Go: "SrcColorBlendFactor", Offset: 0},
+			{Name: "dst_color_blendfactor", Go: "DstColorBlendFactor", Offset: 4},
+			{Name: "color_blend_op", Go: "ColorBlendOp", Offset: 8},
+			{Name: "src_alpha_blendfactor", Go: "SrcAlphaBlendFactor", Offset: 12},
+			{Name: "dst_alpha_blendfactor", Go: "DstAlphaBlendFactor", Offset: 16},
+			{Name: "alpha_blend_op", Go: "AlphaBlendOp", Offset: 20},
+			{Name: "color_write_mask", Go: "ColorWriteMask", Offset: 24},
+			{Name: "enable_blend", Go: "EnableBlend", Offset: 25},
+			{Name: "enable_color_write_mask", Go: "EnableColorWriteMask", Offset: 26},
+		},
+	},
+	{
+		Name: "SDL_GPUColorTargetDescription", Type: reflect.TypeOf(GPUColorTargetDescription{}), Size: 36, Align: 4,
+		Fields: []shared.Field{
+			{Name: "format", Go: "Format", Offset: 0},
+			{Name: "blend_state", Go: "BlendState", Offset: 4},
+		},
+	},
+	{
+		Name: "SDL_GPUColorTargetInfo", Type: reflect.TypeOf(GPUColorTargetInfo{}), Size: 64, Align: 8,
+		Fields: []shared.Field{
+			{Name: "texture", Go: "Texture", Offset: 0},
+			{Name: "mip_level", Go: "MipLevel", Offset: 8},
+			{Name: "layer_or_depth_plane", Go: "LayerOrDepthPlane", Offset: 12},
+			{Name: "clear_color", Go: "ClearColor", Offset: 16},
+			{Name: "load_op", Go: "LoadOp", Offset: 32},
+			{Name: "store_op", Go: "StoreOp", Offset: 36},
+			{Name: "resolve_texture", Go: "ResolveTexture", Offset: 40},
+			{Name: "resolve_mip_level", Go: "ResolveMipLevel", Offset: 48},
+			{Name: "resolve_layer", Go: "ResolveLayer", Offset: 52},
+			{Name: "cycle", Go: "Cycle", Offset: 56},
+			{Name: "cycle_resolve_texture", Go: "CycleResolveTexture", Offset: 57},
+		},
+	},
+	{
+		Name: "SDL_GPUComputePipelineCreateInfo", Type: reflect.TypeOf(GPUComputePipelineCreateInfo{}), Size: 72, Align: 8,
+		Fields: []shared.Field{
+			{Name: "code_size", Go: "CodeSize", Offset: 0},
+			{Name: "code", Go: "Code", Offset: 8},
+			{Name: "entrypoint", Go: "Entrypoint", Offset: 16},
+			{Name: "format", Go: "Format", Offset: 24},
+			{Name: "num_samplers", Go: "NumSamplers", Offset: 28},
+			{Name: "num_readonly_storage_textures", Go: "NumReadonlyStorageTextures", Offset: 32},
+			{Name: "num_readonly_storage_buffers", Go: "NumReadonlyStorageBuffers", Offset: 36},
+			{Name: "num_readwrite_storage_textures", Go: "NumReadwriteStorageTextures", Offset: 40},
+			{Name: "num_readwrite_storage_buffers", Go: "NumReadwriteStorageBuffers", Offset: 44},
+			{Name: "num_uniform_buffers", Go: "NumUniformBuffers", Offset: 48},
+			{Name: "threadcount_x", Go: "ThreadcountX", Offset: 52},
+			{Name: "threadcount_y", Go: "ThreadcountY", Offset: 56},
+			{Name: "threadcount_z", Go: "ThreadcountZ", Offset: 60},
+			{Name: "props", Go: "Props", Offset: 64},
+		},
+	},
+	{
+		Name: "SDL_GPUDepthStencilState", Type: reflect.TypeOf(GPUDepthStencilState{}), Size: 44, Align: 4,
+		Fields: []shared.Field{
+			{Name: "compare_op", Go: "CompareOp", Offset: 0},
+			{Name: "back_stencil_state", Go: "BackStencilState", Offset: 4},
+			{Name: "front_stencil_state", Go: "FrontStencilState", Offset: 20},
+			{Name: "compare_mask", Go: "CompareMask", Offset: 36},
+			{Name: "write_mask", Go: "WriteMask", Offset: 37},
+			{Name: "enable_depth_test", Go: "EnableDepthTest", Offset: 38},
+			{Name: "enable_depth_write", Go: "EnableDepthWrite", Offset: 39},
+			{Name: "enable_stencil_test", Go: "EnableStencilTest", Offset: 40},
+		},
+	},
+	{
+		Name: "SDL_GPUDepthStencilTargetInfo", Type: reflect.TypeOf(GPUDepthStencilTargetInfo{}), Size: 32, Align: 8,
+		Fields: []shared.Field{
+			{Name: "texture", Go: "Texture", Offset: 0},
+			{Name: "clear_depth", Go: "ClearDepth", Offset: 8},
+			{Name: "load_op", Go: "LoadOp", Offset: 12},
+			{Name: "store_op", Go: "StoreOp", Offset: 16},
+			{Name: "stencil_load_op", Go: "StencilLoadOp", Offset: 20},
+			{Name: "stencil_store_op", Go: "StencilStoreOp", Offset: 24},
+			{Name: "cycle", Go: "Cycle", Offset: 28},
+			{Name: "clear_stencil", Go: "ClearStencil", Offset: 29},
+			{Name: "mip_level", Go: "MipLevel", Offset: 30},
+			{Name: "layer", Go: "Layer", Offset: 31},
+		},
+	},
+	{
+		Name: "SDL_GPUGraphicsPipelineCreateInfo", Type: reflect.TypeOf(GPUGraphicsPipelineCreateInfo{}), Size: 168, Align: 8,
+		Fields: []shared.Field{
+			{Name: "vertex_shader", Go: "VertexShader", Offset: 0},
+			{Name: "fragment_shader", Go: "FragmentShader", Offset: 8},
+			{Name: "vertex_input_state", Go: "VertexInputState", Offset: 16},
+			{Name: "primitive_type", Go: "PrimitiveType", Offset: 48},
+			{Name: "rasterizer_state", Go: "RasterizerState", Offset: 52},
+			{Name: "multisample_state", Go: "MultisampleState", Offset: 80},
+			{Name: "depth_stencil_state", Go: "DepthStencilState", Offset: 92},
+			{Name: "target_info", Go: "TargetInfo", Offset: 136},
+			{Name: "props", Go: "Props", Offset: 160},
+		},
+	},
+	{
+		Name: "SDL_GPUGraphicsPipelineTargetInfo", Type: reflect.TypeOf(GPUGraphicsPipelineTargetInfo{}), Size: 24, Align: 8,
+		Fields: []shared.Field{
+			{Name: "color_target_descriptions", Go: "ColorTargetDescriptions", Offset: 0},
+			{Name: "num_color_targets", Go: "NumColorTargets", Offset: 8},
+			{Name: "depth_stencil_format", Go: "DepthStencilFormat", Offset: 12},
+			{Name: "has_depth_stencil_target", Go: "HasDepthStencilTarget", Offset: 16},
+		},
+	},
+	{
+		Name: "SDL_GPUIndexedIndirectDrawCommand", Type: reflect.TypeOf(GPUIndexedIndirectDrawCommand{}), Size: 20, Align: 4,
+		Fields: []shared.Field{
+			{Name: "num_indices", Go: "NumIndices", Offset: 0},
+			{Name: "num_instances", Go: "NumInstances", Offset: 4},
+			{Name: "first_index", Go: "FirstIndex", Offset: 8},
+			{Name: "vertex_offset", Go: "VertexOffset", Offset: 12},
+			{Name: "first_instance", Go: "FirstInstance", Offset: 16},
+		},
+	},
+	{
+		Name: "SDL_GPUIndirectDispatchCommand", Type: reflect.TypeOf(GPUIndirectDispatchCommand{}), Size: 12, Align: 4,
+		Fields: []shared.Field{
+			{Name: "groupcount_x", Go: "GroupcountX", Offset: 0},
+			{Name: "groupcount_y", Go: "GroupcountY", Offset: 4},
+			{Name: "groupcount_z", Go: "GroupcountZ", Offset: 8},
+		},
+	},
+	{
+		Name: "SDL_GPUIndirectDrawCommand", Type: reflect.TypeOf(GPUIndirectDrawCommand{}), Size: 16, Align: 4,
+		Fields: []shared.Field{
+			{Name: "num_vertices", Go: "NumVertices", Offset: 0},
+			{Name: "num_instances", Go: "NumInstances", Offset: 4},
+			{Name: "first_vertex", Go: "FirstVertex", Offset: 8},
+			{Name: "first_instance", Go: "FirstInstance", Offset: 12},
+		},
+	},
+	{
+		Name: "SDL_GPUMultisampleState", Type: reflect.TypeOf(GPUMultisampleState{}), Size: 12, Align: 4,
+		Fields: []shared.Field{
+			{Name: "sample_count", Go: "SampleCount", Offset: 0},
+			{Name: "sample_mask", Go: "SampleMask", Offset: 4},
+			{Name: "enable_mask", Go: "EnableMask", Offset: 8},
+			{Name: "enable_alpha_to_coverage", Go: "EnableAlphaToCoverage", Offset: 9},
+		},
+	},
+	{
+		Name: "SDL_GPURasterizerState", Type: reflect.TypeOf(GPURasterizerState{}), Size: 28, Align: 4,
+		Fields: []shared.Field{
+			{Name: "fill_mode", Go: "FillMode", Offset: 0},
+			{Name: "cull_mode", Go: "CullMode", Offset: 4},
+			{Name: "front_face", Go: "FrontFace", Offset: 8},
+			{Name: "depth_bias_constant_factor", Go: "DepthBiasConstantFactor", Offset: 12},
+			{Name: "depth_bias_clamp", Go: "DepthBiasClamp", Offset: 16},
+			{Name: "depth_bias_slope_factor", Go: "DepthBiasSlopeFactor", Offset: 20},
+			{Name: "enable_depth_bias", Go: "EnableDepthBias", Offset: 24},
+			{Name: "enable_depth_clip", Go: "EnableDepthClip", Offset: 25},
+		},
+	},
+	{
+		Name: "SDL_GPURenderStateCreateInfo", Type: reflect.TypeOf(GPURenderStateCreateInfo{}), Size: 64, Align: 8,
+		Fields: []shared.Field{
+			{Name: "fragment_shader", Go: "FragmentShader", Offset: 0},
+			{Name: "num_sampler_bindings", Go: "NumSamplerBindings", Offset: 8},
+			{Name: "sampler_bindings", Go: "SamplerBindings", Offset: 16},
+			{Name: "num_storage_textures", Go: "NumStorageTextures", Offset: 24},
+			{Name: "storage_textures", Go: "StorageTextures", Offset: 32},
+			{Name: "num_storage_buffers", Go: "NumStorageBuffers", Offset: 40},
+			{Name: "storage_buffers", Go: "StorageBuffers", Offset: 48},
+			{Name: "props", Go: "Props", Offset: 56},
+		},
+	},
+	{
+		Name: "SDL_GPUSamplerCreateInfo", Type: reflect.TypeOf(GPUSamplerCreateInfo{}), Size: 52, Align: 4,
+		Fields: []shared.Field{
+			{Name: "min_filter", Go: "MinFilter", Offset: 0},
+			{Name: "mag_filter", Go: "MagFilter", Offset: 4},
+			{Name: "mipmap_mode", Go: "MipmapMode", Offset: 8},
+			{Name: "address_mode_u", Go: "AddressModeU", Offset: 12},
+			{Name: "address_mode_v", Go: "AddressModeV", Offset: 16},
+			{Name: "address_mode_w", Go: "AddressModeW", Offset: 20},
+			{Name: "mip_lod_bias", Go: "MipLodBias", Offset: 24},
+			{Name: "max_anisotropy", Go: "MaxAnisotropy", Offset: 28},
+			{Name: "compare_op", Go: "CompareOp", Offset: 32},
+			{Name: "min_lod", Go: "MinLod", Offset: 36},
+			{Name: "max_lod", Go: "MaxLod", Offset: 40},
+			{Name: "enable_anisotropy", Go: "EnableAnisotropy", Offset: 44},
+			{Name: "enable_compare", Go: "EnableCompare", Offset: 45},
+			{Name: "props", Go: "Props", Offset: 48},
+		},
+	},
+	{
+		Name: "SDL_GPUShaderCreateInfo", Type: reflect.TypeOf(GPUShaderCreateInfo{}), Size: 56, Align: 8,
+		Fields: []shared.Field{
+			{Name: "code_size", Go: "CodeSize", Offset: 0},
+			{Name: "code", Go: "Code", Offset: 8},
+			{Name: "entrypoint", Go: "entryPoint", Offset: 16},
+			{Name: "format", Go: "Format", Offset: 24},
+			{Name: "stage", Go: "Stage", Offset: 28},
+			{Name: "num_samplers", Go: "NumSamplers", Offset: 32},
+			{Name: "num_storage_textures", Go: "NumStorageTextures", Offset: 36},
+			{Name: "num_storage_buffers", Go: "NumStorageBuffers", Offset: 40},
+			{Name: "num_uniform_buffers", Go: "NumUniformBuffers", Offset: 44},
+			{Name: "props", Go: "Props", Offset: 48},
+		},
+	},
+	{
+		Name: "SDL_GPUStencilOpState", Type: reflect.TypeOf(GPUStencilOpState{}), Size: 16, Align: 4,
+		Fields: []shared.Field{
+			{Name: "fail_op", Go: "FailOp", Offset: 0},
+			{Name: "pass_op", Go: "PassOp", Offset: 4},
+			{Name: "depth_fail_op", Go: "DepthFailOp", Offset: 8},
+			{Name: "compare_op", Go: "CompareOp", Offset: 12},
+		},
+	},
+	{
+		Name: "SDL_GPUStorageBufferReadWriteBinding", Type: reflect.TypeOf(GPUStorageBufferReadWriteBinding{}), Size: 16, Align: 8,
+		Fields: []shared.Field{
+			{Name: "buffer", Go: "Buffer", Offset: 0},
+			{Name: "cycle", Go: "Cycle", Offset: 8},
+		},
+	},
+	{
+		Name: "SDL_GPUStorageTextureReadWriteBinding", Type: reflect.TypeOf(GPUStorageTextureReadWriteBinding{}), Size: 24, Align: 8,
+		Fields: []shared.Field{
+			{Name: "texture", Go: "Texture", Offset: 0},
+			{Name: "mip_level", Go: "MipLevel", Offset: 8},
+			{Name: "layer", Go: "Layer", Offset: 12},
+			{Name: "cycle", Go: "Cycle", Offset: 16},
+		},
+	},
+	{
+		Name: "SDL_GPUTextureCreateInfo", Type: reflect.TypeOf(GPUTextureCreateInfo{}), Size: 36, Align: 4,
+		Fields: []shared.Field{
+			{Name: "type", Go: "Type", Offset: 0},
+			{Name: "format", Go: "Format", Offset: 4},
+			{Name: "usage", Go: "Usage", Offset: 8},
+			{Name: "width", Go: "Width", Offset: 12},
+			{Name: "height", Go: "Height", Offset: 16},
+			{Name: "layer_count_or_depth", Go: "LayerCountOrDepth", Offset: 20},
+			{Name: "num_levels", Go: "NumLevels", Offset: 24},
+			{Name: "sample_count", Go: "SampleCount", Offset: 28},
+			{Name: "props", Go: "Props", Offset: 32},
+		},
+	},
+	{
+		Name: "SDL_GPUTextureLocation", Type: reflect.TypeOf(GPUTextureLocation{}), Size: 32, Align: 8,
+		Fields: []shared.Field{
+			{Name: "texture", Go: "Texture", Offset: 0},
+			{Name: "mip_level", Go: "MipLevel", Offset: 8},
+			{Name: "layer", Go: "Layer", Offset: 12},
+			{Name: "x", Go: "X", Offset: 16},
+			{Name: "y", Go: "Y", Offset: 20},
+			{Name: "z", Go: "Z", Offset: 24},
+		},
+	},
+	{
+		Name: "SDL_GPUTextureRegion", Type: reflect.TypeOf(GPUTextureRegion{}), Size: 40, Align: 8,
+		Fields: []shared.Field{
+			{Name: "texture", Go: "Texture", Offset: 0},
+			{Name: "mip_level", Go: "MipLevel", Offset: 8},
+			{Name: "layer", Go: "Layer", Offset: 12},
+			{Name: "x", Go: "X", Offset: 16},
+			{Name: "y", Go: "Y", Offset: 20},
+			{Name: "z", Go: "Z", Offset: 24},
+			{Name: "w", Go: "W", Offset: 28},
+			{Name: "h", Go: "H", Offset: 32},
+			{Name: "d", Go: "D", Offset: 36},
+		},
+	},
+	{
+		Name: "SDL_GPUTextureSamplerBinding", Type: reflect.TypeOf(GPUTextureSamplerBinding{}), Size: 16, Align: 8,
+		Fields: []shared.Field{
+			{Name: "texture", Go: "Texture", Offset: 0},
+			{Name: "sampler", Go: "Sampler", Offset: 8},
+		},
+	},
+	{
+		Name: "SDL_GPUTextureTransferInfo", Type: reflect.TypeOf(GPUTextureTransferInfo{}), Size: 24, Align: 8,
+		Fields: []shared.Field{
+			{Name: "transfer_buffer", Go: "TransferBuffer", Offset: 0},
+			{Name: "offset", Go: "Offset", Offset: 8},
+			{Name: "pixels_per_row", Go: "PixelsPerRow", Offset: 12},
+			{Name: "rows_per_layer", Go: "RowsPerLayer", Offset: 16},
+		},
+	},
+	{
+		Name: "SDL_GPUTransferBufferCreateInfo", Type: reflect.TypeOf(GPUTransferBufferCreateInfo{}), Size: 12, Align: 4,
+		Fields: []shared.Field{
+			{Name: "usage", Go: "Usage", Offset: 0},
+			{Name: "size", Go: "Size", Offset: 4},
+			{Name: "props", Go: "Props", Offset: 8},
+		},
+	},
+	{
+		Name: "SDL_GPUTransferBufferLocation", Type: reflect.TypeOf(GPUTransferBufferLocation{}), Size: 16, Align: 8,
+		Fields: []shared.Field{
+			{Name: "transfer_buffer", Go: "TransferBuffer", Offset: 0},
+			{Name: "offset", Go: "Offset", Offset: 8},
+		},
+	},
+	{
+		Name: "SDL_GPUVertexAttribute", Type: reflect.TypeOf(GPUVertexAttribute{}), Size: 16, Align: 4,
+		Fields: []shared.Field{
+			{Name: "location", Go: "Location", Offset: 0},
+			{Name: "buffer_slot", Go: "BufferSlot", Offset: 4},
+			{Name: "format", Go: "Format", Offset: 8},
+			{Name: "offset", Go: "Offset", Offset: 12},
+		},
+	},
+	{
+		Name: "SDL_GPUVertexBufferDescription", Type: reflect.TypeOf(GPUVertexBufferDescription{}), Size: 16, Align: 4,
+		Fields: []shared.Field{
+			{Name: "slot", Go: "Slot", Offset: 0},
+			{Name: "pitch", Go: "Pitch", Offset: 4},
+			{Name: "input_rate", Go: "InputRate", Offset: 8},
+			{Name: "instance_step_rate", Go: "InstanceStepRate", Offset: 12},
+		},
+	},
+	{
+		Name: "SDL_GPUVertexInputState", Type: reflect.TypeOf(GPUVertexInputState{}), Size: 32, Align: 8,
+		Fields: []shared.Field{
+			{Name: "vertex_buffer_descriptions", Go: "VertexBufferDescriptions", Offset: 0},
+			{Name: "num_vertex_buffers", Go: "NumVertexBuffers", Offset: 8},
+			{Name: "vertex_attributes", Go: "VertexAttributes", Offset: 16},
+			{Name: "num_vertex_attributes", Go: "NumVertexAttributes", Offset: 24},
+		},
+	},
+	{
+		Name: "SDL_GPUViewport", Type: reflect.TypeOf(GPUViewport{}), Size: 24, Align: 4,
+		Fields: []shared.Field{
+			{Name: "x", Go: "X", Offset: 0},
+			{Name: "y", Go: "Y", Offset: 4},
+			{Name: "w", Go: "W", Offset: 8},
+			{Name: "h", Go: "H", Offset: 12},
+			{Name: "min_depth", Go: "MinDepth", Offset: 16},
+			{Name: "max_depth", Go: "MaxDepth", Offset: 20},
+		},
+	},
+	{
+		Name: "SDL_GPUVulkanOptions", Type: reflect.TypeOf(GPUVulkanOptions{}), Size: 56, Align: 8,
+		Fields: []shared.Field{
+			{Name: "vulkan_api_version", Go: "VulkanApiVersion", Offset: 0},
+			{Name: "feature_list", Go: "FeatureList", Offset: 8},
+			{Name: "vulkan_10_physical_device_features", Go: "Vulkan10PhysicalDeviceFeatures", Offset: 16},
+			{Name: "device_extension_count", Go: "DeviceExtensionCount", Offset: 24},
+			{Name: "device_extension_names", Go: "DeviceExtensionNames", Offset: 32},
+			{Name: "instance_extension_count", Go: "InstanceExtensionCount", Offset: 40},
+			{Name: "instance_extension_names", Go: "InstanceExtensionNames", Offset: 48},
+		},
+	},
+	{
+		Name: "SDL_HapticCondition", Type: reflect.TypeOf(HapticCondition{}), Size: 68, Align: 4,
+		Fields: []shared.Field{
+			{Name: "type", Go: "Type", Offset: 0},
+			{Name: "direction", Go: "Direction", Offset: 4},
+			{Name: "length", Go: "Length", Offset: 20},
+			{Name: "delay", Go: "Delay", Offset: 24},
+			{Name: "button", Go: "Button", Offset: 26},
+			{Name: "interval", Go: "Interval", Offset: 28},
+			{Name: "right_sat", Go: "RightSat", Offset: 30},
+			{Name: "left_sat", Go: "LeftSat", Offset: 36},
+			{Name: "right_coeff", Go: "RightCoeff", Offset: 42},
+			{Name: "left_coeff", Go: "LeftCoeff", Offset: 48},
+			{Name: "deadband", Go: "Deadband", Offset: 54},
+			{Name: "center", Go: "Center", Offset: 60},
+		},
+	},
+	{
+		Name: "SDL_HapticConstant", Type: reflect.TypeOf(HapticConstant{}), Size: 40, Align: 4,
+		Fields: []shared.Field{
+			{Name: "type", Go: "Type", Offset: 0},
+			{Name: "direction", Go: "Direction", Offset: 4},
+			{Name: "length", Go: "Length", Offset: 20},
+			{Name: "delay", Go: "Delay", Offset: 24},
+			{Name: "button", Go: "Button", Offset: 26},
+			{Name: "interval", Go: "Interval", Offset: 28},
+			{Name: "level", Go: "Level", Offset: 30},
+			{Name: "attack_length", Go: "AttackLength", Offset: 32},
+			{Name: "attack_level", Go: "AttackLevel", Offset: 34},
+			{Name: "fade_length", Go: "FadeLength", Offset: 36},
+			{Name: "fade_level", Go: "FadeLevel", Offset: 38},
+		},
+	},
+	{
+		Name: "SDL_HapticCustom", Type: reflect.TypeOf(HapticCustom{}), Size: 56, Align: 8,
+		Fields: []shared.Field{
+			{Name: "type", Go: "Type", Offset: 0},
+			{Name: "direction", Go: "Direction", Offset: 4},
+			{Name: "length", Go: "Length", Offset: 20},
+			{Name: "delay", Go: "Delay", Offset: 24},
+			{Name: "button", Go: "Button", Offset: 26},
+			{Name: "interval", Go: "Interval", Offset: 28},
+			{Name: "channels", Go: "Channels", Offset: 30},
+			{Name: "period", Go: "Period", Offset: 32},
+			{Name: "samples", Go: "Samples", Offset: 34},
+			{Name: "data", Go: "Data", Offset: 40},
+			{Name: "attack_length", Go: "AttackLength", Offset: 48},
+			{Name: "attack_level", Go: "AttackLevel", Offset: 50},
+			{Name: "fade_length", Go: "FadeLength", Offset: 52},
+			{Name: "fade_level", Go: "FadeLevel", Offset: 54},
+		},
+	},
+	{
+		Name: "SDL_HapticDirection", Type: reflect.TypeOf(HapticDirection{}), Size: 16, Align: 4,
+		Fields: []shared.Field{
+			{Name: "type", Go: "Type", Offset: 0},
+			{Name: "dir", Go: "Dir", Offset: 4},
+		},
+	},
+	{
+		Name: "SDL_HapticEffect", Type: reflect.TypeOf(HapticEffect{}), Size: 72, Align: 8,
+		Union: true,
+	},
+	{
+		Name: "SDL_HapticLeftRight", Type: reflect.TypeOf(HapticLeftRight{}), Size: 12, Align: 4,
+		Fields: []shared.Field{
+			{Name: "type", Go: "Type", Offset: 0},
+			{Name: "length", Go: "Length", Offset: 4},
+			{Name: "large_magnitude", Go: "LargeMagnitude", Offset: 8},
+			{Name: "small_magnitude", Go: "SmallMagnitude", Offset: 10},
+		},
+	},
+	{
+		Name: "SDL_HapticPeriodic", Type: reflect.TypeOf(HapticPeriodic{}), Size: 48, Align: 4,
+		Fields: []shared.Field{
+			{Name: "type", Go: "Type", Offset: 0},
+			{Name: "direction", Go: "Direction", Offset: 4},
+			{Name: "length", Go: "Length", Offset: 20},
+			{Name: "delay", Go: "Delay", Offset: 24},
+			{Name: "button", Go: "Button", Offset: 26},
+			{Name: "interval", Go: "Interval", Offset: 28},
+			{Name: "period", Go: "Period", Offset: 30},
+			{Name: "magnitude", Go: "Magnitude", Offset: 32},
+			{Name: "offset", Go: "Offset", Offset: 34},
+			{Name: "phase", Go: "Phase", Offset: 36},
+			{Name: "attack_length", Go: "AttackLength", Offset: 38},
+			{Name: "attack_level", Go: "AttackLevel", Offset: 40},
+			{Name: "fade_length", Go: "FadeLength", Offset: 42},
+			{Name: "fade_level", Go: "FadeLevel", Offset: 44},
+		},
+	},
+	{
+		Name: "SDL_HapticRamp", Type: reflect.TypeOf(HapticRamp{}), Size: 44, Align: 4,
+		Fields: []shared.Field{
+			{Name: "type", Go: "Type", Offset: 0},
+			{Name: "direction", Go: "Direction", Offset: 4},
+			{Name: "length", Go: "Length", Offset: 20},
+			{Name: "delay", Go: "Delay", Offset: 24},
+			{Name: "button", Go: "Button", Offset: 26},
+			{Name: "interval", Go: "Interval", Offset: 28},
+			{Name: "start", Go: "Start", Offset: 30},
+			{Name: "end", Go: "End", Offset: 32},
+			{Name: "attack_length", Go: "AttackLength", Offset: 34},
+			{Name: "attack_level", Go: "AttackLevel", Offset: 36},
+			{Name: "fade_length", Go: "FadeLength", Offset: 38},
+			{Name: "fade_level", Go: "FadeLevel", Offset: 40},
+		},
+	},
+	{
+		Name: "SDL_hid_device_info", Type: reflect.TypeOf(HidDeviceInfo{}), Size: 80, Align: 8,
+		Fields: []shared.Field{
+			{Name: "path", Go: "Path", Offset: 0},
+			{Name: "vendor_id", Go: "VendorId", Offset: 8},
+			{Name: "product_id", Go: "ProductId", Offset: 10},
+			{Name: "serial_number", Go: "SerialNumber", Offset: 16},
+			{Name: "release_number", Go: "ReleaseNumber", Offset: 24},
+			{Name: "manufacturer_string", Go: "ManufacturerString", Offset: 32},
+			{Name: "product_string", Go: "ProductString", Offset: 40},
+			{Name: "usage_page", Go: "UsagePage", Offset: 48},
+			{Name: "usage", Go: "Usage", Offset: 50},
+			{Name: "interface_number", Go: "InterfaceNumber", Offset: 52},
+			{Name: "interface_class", Go: "InterfaceClass", Offset: 56},
+			{Name: "interface_subclass", Go: "InterfaceSubclass", Offset: 60},
+			{Name: "interface_protocol", Go: "InterfaceProtocol", Offset: 64},
+			{Name: "bus_type", Go: "BusType", Offset: 68},
+			{Name: "next", Go: "Next", Offset: 72},
+		},
+	},
+	{
+		Name: "SDL_InitState", Type: reflect.TypeOf(InitState{}), Size: 24, Align: 8,
+		Fields: []shared.Field{
+			{Name: "status", Go: "Status", Offset: 0},
+			{Name: "thread", Go: "Thread", Offset: 8},
+			{Name: "reserved", Go: "Reserved", Offset: 16},
+		},
+	},
+	{
+		Name: "SDL_IOStreamInterface", Type: reflect.TypeOf(IOStreamInterface{}), Size: 56, Align: 8,
+		Fields: []shared.Field{
+			{Name: "version", Go: "Version", Offset: 0},
+			{Name: "size", Go: "Size", Offset: 8},
+			{Name: "seek", Go: "Seek", Offset: 16},
+			{Name: "read", Go: "Read", Offset: 24},
+			{Name: "write", Go: "Write", Offset: 32},
+			{Name: "flush", Go: "Flush", Offset: 40},
+			{Name: "close", Go: "Close", Offset: 48},
+		},
+	},
+	{
+		Name: "SDL_JoyAxisEvent", Type: reflect.TypeOf(JoyAxisEvent{}), Size: 32, Align: 8,
+		Fields: []shared.Field{
+			{Name: "type", Go: "Type", Offset: 0},
+			{Name: "reserved", Go: "Reserved", Offset: 4},
+			{Name: "timestamp", Go: "Timestamp", Offset: 8},
+			{Name: "which", Go: "Which", Offset: 16},
+			{Name: "axis", Go: "Axis", Offset: 20},
+			{Name: "value", Go: "Value", Offset: 24},
+		},
+	},
+	{
+		Name: "SDL_JoyBallEvent", Type: reflect.TypeOf(JoyBallEvent{}), Size: 32, Align: 8,
+		Fields: []shared.Field{
+			{Name: "type", Go: "Type", Offset: 0},
+			{Name: "reserved", Go: "Reserved", Offset: 4},
+			{Name: "timestamp", Go: "Timestamp", Offset: 8},
+			{Name: "which", Go: "Which", Offset: 16},
+			{Name: "ball", Go: "Ball", Offset: 20},
+			{Name: "xrel", Go: "Xrel", Offset: 24},
+			{Name: "yrel", Go: "Yrel", Offset: 26},
+		},
+	},
+	{
+		Name: "SDL_JoyBatteryEvent", Type: reflect.TypeOf(JoyBatteryEvent{}), Size: 32, Align: 8,
+		Fields: []shared.Field{
+			{Name: "type", Go: "Type", Offset: 0},
+			{Name: "reserved", Go: "Reserved", Offset: 4},
+			{Name: "timestamp", Go: "Timestamp", Offset: 8},
+			{Name: "which", Go: "Which", Offset: 16},
+			{Name: "state", Go: "State", Offset: 20},
+			{Name: "percent", Go: "Percent", Offset: 24},
+		},
+	},
+	{
+		Name: "SDL_JoyButtonEvent", Type: reflect.TypeOf(JoyButtonEvent{}), Size: 24, Align: 8,
+		Fields: []shared.Field{
+			{Name: "type", Go: "Type", Offset: 0},
+			{Name: "reserved", Go: "Reserved", Offset: 4},
+			{Name: "timestamp", Go: "Timestamp", Offset: 8},
+			{Name: "which", Go: "Which", Offset: 16},
+			{Name: "button", Go: "Button", Offset: 20},
+			{Name: "down", Go: "Down", Offset: 21},
+		},
+	},
+	{
+		Name: "SDL_JoyDeviceEvent", Type: reflect.TypeOf(JoyDeviceEvent{}), Size: 24, Align: 8,
+		Fields: []shared.Field{
+			{Name: "type", Go: "Type", Offset: 0},
+			{Name: "reserved", Go: "Reserved", Offset: 4},
+			{Name: "timestamp", Go: "Timestamp", Offset: 8},
+			{Name: "which", Go: "Which", Offset: 16},
+		},
+	},
+	{
+		Name: "SDL_JoyHatEvent", Type: reflect.TypeOf(JoyHatEvent{}), Size: 24, Align: 8,
+		Fields: []shared.Field{
+			{Name: "type", Go: "Type", Offset: 0},
+			{Name: "reserved", Go: "Reserved", Offset: 4},
+			{Name: "timestamp", Go: "Timestamp", Offset: 8},
+			{Name: "which", Go: "Which", Offset: 16},
+			{Name: "hat", Go: "Hat", Offset: 20},
+			{Name: "value", Go: "Value", Offset: 21},
+		},
+	},
+	{
+		Name: "SDL_KeyboardDeviceEvent", Type: reflect.TypeOf(KeyboardDeviceEvent{}), Size: 24, Align: 8,
+		Fields: []shared.Field{
+			{Name: "type", Go: "Type", Offset: 0},
+			{Name: "reserved", Go: "Reserved", Offset: 4},
+			{Name: "timestamp", Go: "Timestamp", Offset: 8},
+			{Name: "which", Go: "Which", Offset: 16},
+		},
+	},
+	{
+		Name: "SDL_KeyboardEvent", Type: reflect.TypeOf(KeyboardEvent{}), Size: 40, Align: 8,
+		Fields: []shared.Field{
+			{Name: "type", Go: "Type", Offset: 0},
+			{Name: "reserved", Go: "Reserved", Offset: 4},
+			{Name: "timestamp", Go: "Timestamp", Offset: 8},
+			{Name: "windowID", Go: "WindowID", Offset: 16},
+			{Name: "which", Go: "Which", Offset: 20},
+			{Name: "scancode", Go: "Scancode", Offset: 24},
+			{Name: "key", Go: "Key", Offset: 28},
+			{Name: "mod", Go: "Mod", Offset: 32},
+			{Name: "raw", Go: "Raw", Offset: 34},
+			{Name: "down", Go: "Down", Offset: 36},
+			{Name: "repeat", Go: "Repeat", Offset: 37},
+		},
+	},
+	{
+		Name: "SDL_MessageBoxButtonData", Type: reflect.TypeOf(MessageBoxButtonData{}), Size: 16, Align: 8,
+		Fields: []shared.Field{
+			{Name: "flags", Go: "Flags", Offset: 0},
+			{Name: "buttonID", Go: "ButtonID", Offset: 4},
+			{Name: "text", Go: "Text", Offset: 8},
+		},
+	},
+	{
+		Name: "SDL_MessageBoxColor", Type: reflect.TypeOf(MessageBoxColor{}), Size: 3, Align: 1,
+		Fields: []shared.Field{
+			{Name: "r", Go: "R", Offset: 0},
+			{Name: "g", Go: "G", Offset: 1},
+			{Name: "b", Go: "B", Offset: 2},
+		},
+	},
+	{
+		Name: "SDL_MessageBoxColorScheme", Type: reflect.TypeOf(MessageBoxColorScheme{}), Size: 15, Align: 1,
+		Fields: []shared.Field{
+			{Name: "colors", Go: "Colors", Offset: 0},
+		},
+	},
+	{
+		Name: "SDL_MessageBoxData", Type: reflect.TypeOf(MessageBoxData{}), Size: 56, Align: 8,
+		Fields: []shared.Field{
+			{Name: "flags", Go: "Flags", Offset: 0},
+			{Name: "window", Go: "Window", Offset: 8},
+			{Name: "title", Go: "Title", Offset: 16},
+			{Name: "message", Go: "Message", Offset: 24},
+			{Name: "numbuttons", Go: "Numbuttons", Offset: 32},
+			{Name: "buttons", Go: "Buttons", Offset: 40},
+			{Name: "colorScheme", Go: "ColorScheme", Offset: 48},
+		},
+	},
+	{
+		Name: "SDL_MouseButtonEvent", Type: reflect.TypeOf(MouseButtonEvent{}), Size: 40, Align: 8,
+		Fields: []shared.Field{
+			{Name: "type", Go: "Type", Offset: 0},
+			{Name: "reserved", Go: "Reserved", Offset: 4},
+			{Name: "timestamp", Go: "Timestamp", Offset: 8},
+			{Name: "windowID", Go: "WindowID", Offset: 16},
+			{Name: "which", Go: "Which", Offset: 20},
+			{Name: "button", Go: "Button", Offset: 24},
+			{Name: "down", Go: "Down", Offset: 25},
+			{Name: "clicks", Go: "Clicks", Offset: 26},
+			{Name: "x", Go: "X", Offset: 28},
+			{Name: "y", Go: "Y", Offset: 32},
+		},
+	},
+	{
+		Name: "SDL_MouseDeviceEvent", Type: reflect.TypeOf(MouseDeviceEvent{}), Size: 24, Align: 8,
+		Fields: []shared.Field{
+			{Name: "type", Go: "Type", Offset: 0},
+			{Name: "reserved", Go: "Reserved", Offset: 4},
+			{Name: "timestamp", Go: "Timestamp", Offset: 8},
+			{Name: "which", Go: "Which", Offset: 16},
+		},
+	},
+	{
+		Name: "SDL_MouseMotionEvent", Type: reflect.TypeOf(MouseMotionEvent{}), Size: 48, Align: 8,
+		Fields: []shared.Field{
+			{Name: "type", Go: "Type", Offset: 0},
+			{Name: "reserved", Go: "Reserved", Offset: 4},
+			{Name: "timestamp", Go: "Timestamp", Offset: 8},
+			{Name: "windowID", Go: "WindowID", Offset: 16},
+			{Name: "which", Go: "Which", Offset: 20},
+			{Name: "state", Go: "State", Offset: 24},
+			{Name: "x", Go: "X", Offset: 28},
+			{Name: "y", Go: "Y", Offset: 32},
+			{Name: "xrel", Go: "Xrel", Offset: 36},
+			{Name: "yrel", Go: "Yrel", Offset: 40},
+		},
+	},
+	{
+		Name: "SDL_MouseWheelEvent", Type: reflect.TypeOf(MouseWheelEvent{}), Size: 56, Align: 8,
+		Fields: []shared.Field{
+			{Name: "type", Go: "Type", Offset: 0},
+			{Name: "reserved", Go: "Reserved", Offset: 4},
+			{Name: "timestamp", Go: "Timestamp", Offset: 8},
+			{Name: "windowID", Go: "WindowID", Offset: 16},
+			{Name: "which", Go: "Which", Offset: 20},
+			{Name: "x", Go: "X", Offset: 24},
+			{Name: "y", Go: "Y", Offset: 28},
+			{Name: "direction", Go: "Direction", Offset: 32},
+			{Name: "mouse_x", Go: "MouseX", Offset: 36},
+			{Name: "mouse_y", Go: "MouseY", Offset: 40},
+			{Name: "integer_x", Go: "IntegerX", Offset: 44},
+			{Name: "integer_y", Go: "IntegerY", Offset: 48},
+		},
+	},
+	{
+		Name: "SDL_Palette", Type: reflect.TypeOf(Palette{}), Size: 24, Align: 8,
+		Fields: []shared.Field{
+			{Name: "ncolors", Go: "Ncolors", Offset: 0},
+			{Name: "colors", Go: "Colors", Offset: 8},
+			{Name: "version", Go: "Version", Offset: 16},
+			{Name: "refcount", Go: "Refcount", Offset: 20},
+		},
+	},
+	{
+		Name: "SDL_PathInfo", Type: reflect.TypeOf(PathInfo{}), Size: 40, Align: 8,
+		Fields: []shared.Field{
+			{Name: "type", Go: "Type", Offset: 0},
+			{Name: "size", Go: "Size", Offset: 8},
+			{Name: "create_time", Go: "CreateTime", Offset: 16},
+			{Name: "modify_time", Go: "ModifyTime", Offset: 24},
+			{Name: "access_time", Go: "AccessTime", Offset: 32},
+		},
+	},
+	{
+		Name: "SDL_PenAxisEvent", Type: reflect.TypeOf(PenAxisEvent{}), Size: 48, Align: 8,
+		Fields: []shared.Field{
+			{Name: "type", Go: "Type", Offset: 0},
+			{Name: "reserved", Go: "Reserved", Offset: 4},
+			{Name: "timestamp", Go: "Timestamp", Offset: 8},
+			{Name: "windowID", Go: "WindowID", Offset: 16},
+			{Name: "which", Go: "Which", Offset: 20},
+			{Name: "pen_state", Go: "PenState", Offset: 24},
+			{Name: "x", Go: "X", Offset: 28},
+			{Name: "y", Go: "Y", Offset: 32},
+			{Name: "axis", Go: "Axis", Offset: 36},
+			{Name: "value", Go: "Value", Offset: 40},
+		},
+	},
+	{
+		Name: "SDL_PenButtonEvent", Type: reflect.TypeOf(PenButtonEvent{}), Size: 40, Align: 8,
+		Fields: []shared.Field{
+			{Name: "type", Go: "Type", Offset: 0},
+			{Name: "reserved", Go: "Reserved", Offset: 4},
+			{Name: "timestamp", Go: "Timestamp", Offset: 8},
+			{Name: "windowID", Go: "WindowID", Offset: 16},
+			{Name: "which", Go: "Which", Offset: 20},
+			{Name: "pen_state", Go: "PenState", Offset: 24},
+			{Name: "x", Go: "X", Offset: 28},
+			{Name: "y", Go: "Y", Offset: 32},
+			{Name: "button", Go: "Button", Offset: 36},
+			{Name: "down", Go: "Down", Offset: 37},
+		},
+	},
+	{
+		Name: "SDL_PenMotionEvent", Type: reflect.TypeOf(PenMotionEvent{}), Size: 40, Align: 8,
+		Fields: []shared.Field{
+			{Name: "type", Go: "Type", Offset: 0},
+			{Name: "reserved", Go: "Reserved", Offset: 4},
+			{Name: "timestamp", Go: "Timestamp", Offset: 8},
+			{Name: "windowID", Go: "WindowID", Offset: 16},
+			{Name: "which", Go: "Which", Offset: 20},
+			{Name: "pen_state", Go: "PenState", Offset: 24},
+			{Name: "x", Go: "X", Offset: 28},
+			{Name: "y", Go: "Y", Offset: 32},
+		},
+	},
+	{
+		Name: "SDL_PenProximityEvent", Type: reflect.TypeOf(PenProximityEvent{}), Size: 24, Align: 8,
+		Fields: []shared.Field{
+			{Name: "type", Go: "Type", Offset: 0},
+			{Name: "reserved", Go: "Reserved", Offset: 4},
+			{Name: "timestamp", Go: "Timestamp", Offset: 8},
+			{Name: "windowID", Go: "WindowID", Offset: 16},
+			{Name: "which", Go: "Which", Offset: 20},
+		},
+	},
+	{
+		Name: "SDL_PenTouchEvent", Type: reflect.TypeOf(PenTouchEvent{}), Size: 40, Align: 8,
+		Fields: []shared.Field{
+			{Name: "type", Go: "Type", Offset: 0},
+			{Name: "reserved", Go: "Reserved", Offset: 4},
+			{Name: "timestamp", Go: "Timestamp", Offset: 8},
+			{Name: "windowID", Go: "WindowID", Offset: 16},
+			{Name: "which", Go: "Which", Offset: 20},
+			{Name: "pen_state", Go: "PenState", Offset: 24},
+			{Name: "x", Go: "X", Offset: 28},
+			{Name: "y", Go: "Y", Offset: 32},
+			{Name: "eraser", Go: "Eraser", Offset: 36},
+			{Name: "down", Go: "Down", Offset: 37},
+		},
+	},
+	{
+		Name: "SDL_PinchFingerEvent", Type: reflect.TypeOf(PinchFingerEvent{}), Size: 24, Align: 8,
+		Fields: []shared.Field{
+			{Name: "type", Go: "Type", Offset: 0},
+			{Name: "reserved", Go: "Reserved", Offset: 4},
+			{Name: "timestamp", Go: "Timestamp", Offset: 8},
+			{Name: "scale", Go: "Scale", Offset: 16},
+			{Name: "windowID", Go: "WindowID", Offset: 20},
+		},
+	},
+	{
+		Name: "SDL_PixelFormatDetails", Type: reflect.TypeOf(PixelFormatDetails{}), Size: 32, Align: 4,
+		Fields: []shared.Field{
+			{Name: "format", Go: "Format", Offset: 0},
+			{Name: "bits_per_pixel", Go: "BitsPerPixel", Offset: 4},
+			{Name: "bytes_per_pixel", Go: "BytesPerPixel", Offset: 5},
+			{Name: "padding", Go: "Padding", Offset: 6},
+			{Name: "Rmask", Go: "Rmask", Offset: 8},
+			{Name: "Gmask", Go: "Gmask", Offset: 12},
+			{Name: "Bmask", Go: "Bmask", Offset: 16},
+			{Name: "Amask", Go: "Amask", Offset: 20},
+			{Name: "Rbits", Go: "Rbits", Offset: 24},
+			{Name: "Gbits", Go: "Gbits", Offset: 25},
+			{Name: "Bbits", Go: "Bbits", Offset: 26},
+			{Name: "Abits", Go: "Abits", Offset: 27},
+			{Name: "Rshift", Go: "Rshift", Offset: 28},
+			{Name: "Gshift", Go: "Gshift", Offset: 29},
+			{Name: "Bshift", Go: "Bshift", Offset: 30},
+			{Name: "Ashift", Go: "Ashift", Offset: 31},
+		},
+	},
+	{
+		Name: "SDL_Point", Type: reflect.TypeOf(Point{}), Size: 8, Align: 4,
+		Fields: []shared.Field{
+			{Name: "x", Go: "X", Offset: 0},
+			{Name: "y", Go: "Y", Offset: 4},
+		},
+	},
+	{
+		Name: "SDL_QuitEvent", Type: reflect.TypeOf(QuitEvent{}), Size: 16, Align: 8,
+		Fields: []shared.Field{
+			{Name: "type", Go: "Type", Offset: 0},
+			{Name: "reserved", Go: "Reserved", Offset: 4},
+			{Name: "timestamp", Go: "Timestamp", Offset: 8},
+		},
+	},
+	{
+		Name: "SDL_Rect", Type: reflect.TypeOf(Rect{}), Size: 16, Align: 4,
+		Fields: []shared.Field{
+			{Name: "x", Go: "X", Offset: 0},
+			{Name: "y", Go: "Y", Offset: 4},
+			{Name: "w", Go: "W", Offset: 8},
+			{Name: "h", Go: "H", Offset: 12},
+		},
+	},
+	{
+		Name: "SDL_RenderEvent", Type: reflect.TypeOf(RenderEvent{}), Size: 24, Align: 8,
+		Fields: []shared.Field{
+			{Name: "type", Go: "Type", Offset: 0},
+			{Name: "reserved", Go: "Reserved", Offset: 4},
+			{Name: "timestamp", Go: "Timestamp", Offset: 8},
+			{Name: "windowID", Go: "WindowID", Offset: 16},
+		},
+	},
+	{
+		Name: "SDL_SensorEvent", Type: reflect.TypeOf(SensorEvent{}), Size: 56, Align: 8,
+		Fields: []shared.Field{
+			{Name: "type", Go: "Type", Offset: 0},
+			{Name: "reserved", Go: "Reserved", Offset: 4},
+			{Name: "timestamp", Go: "Timestamp", Offset: 8},
+			{Name: "which", Go: "Which", Offset: 16},
+			{Name: "data", Go: "Data", Offset: 20},
+			{Name: "sensor_timestamp", Go: "SensorTimestamp", Offset: 48},
+		},
+	},
+	{
+		Name: "SDL_StorageInterface", Type: reflect.TypeOf(StorageInterface{}), Size: 96, Align: 8,
+		Fields: []shared.Field{
+			{Name: "version", Go: "Version", Offset: 0},
+			{Name: "close", Go: "Close", Offset: 8},
+			{Name: "ready", Go: "Ready", Offset: 16},
+			{Name: "enumerate", Go: "Enumerate", Offset: 24},
+			{Name: "info", Go: "Info", Offset: 32},
+			{Name: "read_file", Go: "ReadFile", Offset: 40},
+			{Name: "write_file", Go: "WriteFile", Offset: 48},
+			{Name: "mkdir", Go: "Mkdir", Offset: 56},
+			{Name: "remove", Go: "Remove", Offset: 64},
+			{Name: "rename", Go: "Rename", Offset: 72},
+			{Name: "copy", Go: "Copy", Offset: 80},
+			{Name: "space_remaining", Go: "SpaceRemaining", Offset: 88},
+		},
+	},
+	{
+		Name: "SDL_Surface", Type: reflect.TypeOf(Surface{}), Size: 48, Align: 8,
+		Fields: []shared.Field{
+			{Name: "flags", Go: "Flags", Offset: 0},
+			{Name: "format", Go: "Format", Offset: 4},
+			{Name: "w", Go: "W", Offset: 8},
+			{Name: "h", Go: "H", Offset: 12},
+			{Name: "pitch", Go: "Pitch", Offset: 16},
+			{Name: "pixels", Go: "Pixels", Offset: 24},
+			{Name: "refcount", Go: "Refcount", Offset: 32},
+			{Name: "reserved", Go: "Reserved", Offset: 40},
+		},
+	},
+	{
+		Name: "SDL_TextEditingCandidatesEvent", Type: reflect.TypeOf(TextEditingCandidatesEvent{}), Size: 48, Align: 8,
+		Fields: []shared.Field{
+			{Name: "type", Go: "Type", Offset: 0},
+			{Name: "reserved", Go: "Reserved", Offset: 4},
+			{Name: "timestamp", Go: "Timestamp", Offset: 8},
+			{Name: "windowID", Go: "WindowID", Offset: 16},
+			{Name: "candidates", Go: "Candidates", Offset: 24},
+			{Name: "num_candidates", Go: "NumCandidates", Offset: 32},
+			{Name: "selected_candidate", Go: "SelectedCandidate", Offset: 36},
+			{Name: "horizontal", Go: "Horizontal", Offset: 40},
+		},
+	},
+	{
+		Name: "SDL_TextEditingEvent", Type: reflect.TypeOf(TextEditingEvent{}), Size: 40, Align: 8,
+		Fields: []shared.Field{
+			{Name: "type", Go: "Type", Offset: 0},
+			{Name: "reserved", Go: "Reserved", Offset: 4},
+			{Name: "timestamp", Go: "Timestamp", Offset: 8},
+			{Name: "windowID", Go: "WindowID", Offset: 16},
+			{Name: "text", Go: "Text", Offset: 24},
+			{Name: "start", Go: "Start", Offset: 32},
+			{Name: "length", Go: "Length", Offset: 36},
+		},
+	},
+	{
+		Name: "SDL_TextInputEvent", Type: reflect.TypeOf(TextInputEvent{}), Size: 32, Align: 8,
+		Fields: []shared.Field{
+			{Name: "type", Go: "Type", Offset: 0},
+			{Name: "reserved", Go: "Reserved", Offset: 4},
+			{Name: "timestamp", Go: "Timestamp", Offset: 8},
+			{Name: "windowID", Go: "WindowID", Offset: 16},
+			{Name: "text", Go: "Text", Offset: 24},
+		},
+	},
+	{
+		Name: "SDL_Texture", Type: reflect.TypeOf(Texture{}), Size: 16, Align: 4,
+		Fields: []shared.Field{
+			{Name: "format", Go: "Format", Offset: 0},
+			{Name: "w", Go: "W", Offset: 4},
+			{Name: "h", Go: "H", Offset: 8},
+			{Name: "refcount", Go: "Refcount", Offset: 12},
+		},
+	},
+	{
+		Name: "SDL_TouchFingerEvent", Type: reflect.TypeOf(TouchFingerEvent{}), Size: 56, Align: 8,
+		Fields: []shared.Field{
+			{Name: "type", Go: "Type", Offset: 0},
+			{Name: "reserved", Go: "Reserved", Offset: 4},
+			{Name: "timestamp", Go: "Timestamp", Offset: 8},
+			{Name: "touchID", Go: "TouchID", Offset: 16},
+			{Name: "fingerID", Go: "FingerID", Offset: 24},
+			{Name: "x", Go: "X", Offset: 32},
+			{Name: "y", Go: "Y", Offset: 36},
+			{Name: "dx", Go: "Dx", Offset: 40},
+			{Name: "dy", Go: "Dy", Offset: 44},
+			{Name: "pressure", Go: "Pressure", Offset: 48},
+			{Name: "windowID", Go: "WindowID", Offset: 52},
+		},
+	},
+	{
+		Name: "SDL_UserEvent", Type: reflect.TypeOf(UserEvent{}), Size: 40, Align: 8,
+		Fields: []shared.Field{
+			{Name: "type", Go: "Type", Offset: 0},
+			{Name: "reserved", Go: "Reserved", Offset: 4},
+			{Name: "timestamp", Go: "Timestamp", Offset: 8},
+			{Name: "windowID", Go: "WindowID", Offset: 16},
+			{Name: "code", Go: "Code", Offset: 20},
+			{Name: "data1", Go: "Data1", Offset: 24},
+			{Name: "data2", Go: "Data2", Offset: 32},
+		},
+	},
+	{
+		Name: "SDL_Vertex", Type: reflect.TypeOf(Vertex{}), Size: 32, Align: 4,
+		Fields: []shared.Field{
+			{Name: "position", Go: "Position", Offset: 0},
+			{Name: "color", Go: "Color", Offset: 8},
+			{Name: "tex_coord", Go: "TexCoord", Offset: 24},
+		},
+	},
+	{
+		Name: "SDL_VirtualJoystickDesc", Type: reflect.TypeOf(VirtualJoystickDesc{}), Size: 136, Align: 8,
+		Fields: []shared.Field{
+			{Name: "version", Go: "Version", Offset: 0},
+			{Name: "type", Go: "Type", Offset: 4},
+			{Name: "vendor_id", Go: "VendorId", Offset: 8},
+			{Name: "product_id", Go: "ProductId", Offset: 10},
+			{Name: "naxes", Go: "Naxes", Offset: 12},
+			{Name: "nbuttons", Go: "Nbuttons", Offset: 14},
+			{Name: "nballs", Go: "Nballs", Offset: 16},
+			{Name: "nhats", Go: "Nhats", Offset: 18},
+			{Name: "ntouchpads", Go: "Ntouchpads", Offset: 20},
+			{Name: "nsensors", Go: "Nsensors", Offset: 22},
+			{Name: "button_mask", Go: "ButtonMask", Offset: 28},
+			{Name: "axis_mask", Go: "AxisMask", Offset: 32},
+			{Name: "name", Go: "Name", Offset: 40},
+			{Name: "touchpads", Go: "Touchpads", Offset: 48},
+			{Name: "sensors", Go: "Sensors", Offset: 56},
+			{Name: "userdata", Go: "Userdata", Offset: 64},
+			{Name: "Update", Go: "Update", Offset: 72},
+			{Name: "SetPlayerIndex", Go: "SetPlayerIndex", Offset: 80},
+			{Name: "Rumble", Go: "Rumble", Offset: 88},
+			{Name: "RumbleTriggers", Go: "RumbleTriggers", Offset: 96},
+			{Name: "SetLED", Go: "SetLED", Offset: 104},
+			{Name: "SendEffect", Go: "SendEffect", Offset: 112},
+			{Name: "SetSensorsEnabled", Go: "SetSensorsEnabled", Offset: 120},
+			{Name: "Cleanup", Go: "Cleanup", Offset: 128},
+		},
+	},
+	{
+		Name: "SDL_VirtualJoystickSensorDesc", Type: reflect.TypeOf(VirtualJoystickSensorDesc{}), Size: 8, Align: 4,
+		Fields: []shared.Field{
+			{Name: "type", Go: "Type", Offset: 0},
+			{Name: "rate", Go: "Rate", Offset: 4},
+		},
+	},
+	{
+		Name: "SDL_VirtualJoystickTouchpadDesc", Type: reflect.TypeOf(VirtualJoystickTouchpadDesc{}), Size: 8, Align: 2,
+		Fields: []shared.Field{
+			{Name: "nfingers", Go: "NFingers", Offset: 0},
+		},
+	},
+	{
+		Name: "SDL_WindowEvent", Type: reflect.TypeOf(WindowEvent{}), Size: 32, Align: 8,
+		Fields: []shared.Field{
+			{Name: "type", Go: "Type", Offset: 0},
+			{Name: "reserved", Go: "Reserved", Offset: 4},
+			{Name: "timestamp", Go: "Timestamp", Offset: 8},
+			{Name: "windowID", Go: "WindowID", Offset: 16},
+			{Name: "data1", Go: "Data1", Offset: 20},
+			{Name: "data2", Go: "Data2", Offset: 24},
+		},
+	},
+}
@@ -0,0 +1,16 @@
+package sdl
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestVerifyABI(t *testing.T) {
+	err := VerifyABI()
+	if errors.Is(err, ErrABINotMeasured) {
+		t.Skip(err)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+}
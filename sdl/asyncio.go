@@ -0,0 +1,225 @@
+package sdl
+
+import (
+	"runtime"
+	"sync"
+	"time"
+	"unsafe"
+)
+
+// AsyncQueue delivers the results of asynchronous file operations to channels.
+//
+// Results are only delivered while the queue is drained by [AsyncQueue.Poll] or [AsyncQueue.Wait],
+// e.g. once per frame in the main loop:
+//
+//	level, _ := queue.LoadFile("levels/1.bin")
+//	for running {
+//		queue.Poll()
+//		select {
+//		case result := <-level:
+//			// result.Data holds the file or result.Err reports the failure
+//		default:
+//		}
+//		// render a frame
+//	}
+//
+// SDL only gets C memory, which is copied from and into Go slices, so Go buffers are never accessed asynchronously.
+type AsyncQueue struct {
+	queue *AsyncIOQueue
+
+	mu      sync.Mutex
+	pending map[uintptr]*asyncRequest // keyed by the ID passed to SDL as userdata
+	next    uintptr
+}
+
+// AsyncResult is the result of an asynchronous file operation.
+type AsyncResult struct {
+	Type   AsyncIOTaskType // The kind of operation.
+	Offset uint64          // The offset in the file of a read or write.
+	Bytes  uint64          // The number of bytes read or written.
+	Data   []byte          // The data of a read or [AsyncQueue.LoadFile]. It may be shorter than requested at the end of the file.
+	Err    error           // Set unless the operation completed. Failed operations report "request failed" and canceled ones "request canceled".
+}
+
+// AsyncFile is a file opened for asynchronous reads and writes.
+type AsyncFile struct {
+	asyncio *AsyncIO
+}
+
+type asyncRequest struct {
+	fn     string
+	buffer unsafe.Pointer // C memory owned by the request
+	result chan AsyncResult
+}
+
+// NewAsyncQueue creates a queue with [CreateAsyncIOQueue].
+func NewAsyncQueue() (*AsyncQueue, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	queue := CreateAsyncIOQueue()
+	if queue == nil {
+		return nil, NewError("SDL_CreateAsyncIOQueue")
+	}
+	return &AsyncQueue{queue: queue, pending: make(map[uintptr]*asyncRequest)}, nil
+}
+
+// Destroy waits for the pending operations, delivers their results and destroys the queue.
+func (q *AsyncQueue) Destroy() {
+	for q.Pending() > 0 {
+		q.Wait(-1)
+	}
+	DestroyAsyncIOQueue(q.queue)
+	q.queue = nil
+}
+
+// Pending returns the number of operations whose results haven't been delivered yet.
+func (q *AsyncQueue) Pending() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.pending)
+}
+
+// Poll delivers the results of all completed operations without blocking and returns their number.
+func (q *AsyncQueue) Poll() int {
+	var n int
+	var outcome AsyncIOOutcome
+	for GetAsyncIOResult(q.queue, &outcome) {
+		q.deliver(&outcome)
+		n++
+	}
+	return n
+}
+
+// Wait blocks until an operation completes, the timeout expires or [AsyncQueue.Signal] is called,
+// and reports whether a result was delivered. A negative timeout waits indefinitely.
+func (q *AsyncQueue) Wait(timeout time.Duration) bool {
+	var outcome AsyncIOOutcome
+	if !WaitAsyncIOResult(q.queue, &outcome, timeoutMS(timeout)) {
+		return false
+	}
+	q.deliver(&outcome)
+	return true
+}
+
+// Signal wakes up all goroutines blocked in [AsyncQueue.Wait].
+func (q *AsyncQueue) Signal() {
+	SignalAsyncIOQueue(q.queue)
+}
+
+// LoadFile loads a whole file asynchronously with [LoadFileAsync].
+func (q *AsyncQueue) LoadFile(file string) (<-chan AsyncResult, error) {
+	return q.start("SDL_LoadFileAsync", nil, func(userdata unsafe.Pointer) bool {
+		return LoadFileAsync(file, q.queue, userdata)
+	})
+}
+
+// start registers a request and starts it with fn. The buffer is freed when the request ends.
+func (q *AsyncQueue) start(name string, buffer unsafe.Pointer, fn func(userdata unsafe.Pointer) bool) (<-chan AsyncResult, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	req := &asyncRequest{fn: name, buffer: buffer, result: make(chan AsyncResult, 1)}
+
+	q.mu.Lock()
+	q.next++
+	id := q.next
+	q.pending[id] = req
+	q.mu.Unlock()
+
+	if !fn(handlePointer(id)) {
+		err := NewError(name)
+		q.mu.Lock()
+		delete(q.pending, id)
+		q.mu.Unlock()
+		Free(buffer)
+		return nil, err
+	}
+	return req.result, nil
+}
+
+func (q *AsyncQueue) deliver(outcome *AsyncIOOutcome) {
+	q.mu.Lock()
+	req := q.pending[uintptr(outcome.Userdata)]
+	delete(q.pending, uintptr(outcome.Userdata))
+	q.mu.Unlock()
+	if req == nil {
+		return
+	}
+
+	result := AsyncResult{Type: outcome.Type, Offset: outcome.Offset, Bytes: outcome.BytesTransferred}
+	switch outcome.Result {
+	case AsyncIOFailure:
+		// SDL sets the error message on the I/O thread, so it can't be read here.
+		result.Err = &Error{Func: req.fn, Message: "request failed"}
+	case AsyncIOCanceled:
+		result.Err = &Error{Func: req.fn, Message: "request canceled"}
+	}
+	if outcome.Type == AsyncIOTaskRead && outcome.Buffer != nil {
+		result.Data = make([]byte, outcome.BytesTransferred)
+		copy(result.Data, unsafe.Slice((*byte)(outcome.Buffer), outcome.BytesTransferred))
+	}
+
+	if req.buffer != nil {
+		Free(req.buffer)
+	} else if req.fn == "SDL_LoadFileAsync" {
+		// The buffer was allocated by SDL.
+		Free(outcome.Buffer)
+	}
+	req.result <- result
+}
+
+// OpenAsyncFile opens a file for asynchronous I/O with [AsyncIOFromFile]. The mode is like the one of [IOFromFile].
+func OpenAsyncFile(file, mode string) (*AsyncFile, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	asyncio := AsyncIOFromFile(file, mode)
+	if asyncio == nil {
+		return nil, NewError("SDL_AsyncIOFromFile")
+	}
+	return &AsyncFile{asyncio: asyncio}, nil
+}
+
+// Size returns the size of the file with [GetAsyncIOSize].
+func (f *AsyncFile) Size() (int64, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	size := GetAsyncIOSize(f.asyncio)
+	if size < 0 {
+		return 0, NewError("SDL_GetAsyncIOSize")
+	}
+	return size, nil
+}
+
+// Read starts reading size bytes at offset with [ReadAsyncIO]. The result is delivered by q.
+func (f *AsyncFile) Read(q *AsyncQueue, offset, size uint64) (<-chan AsyncResult, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	buffer := Malloc(size)
+	if buffer == nil && size > 0 {
+		return nil, NewError("SDL_malloc")
+	}
+	return q.start("SDL_ReadAsyncIO", buffer, func(userdata unsafe.Pointer) bool {
+		return ReadAsyncIO(f.asyncio, buffer, offset, size, q.queue, userdata)
+	})
+}
+
+// Write starts writing a copy of data at offset with [WriteAsyncIO]. The result is delivered by q.
+func (f *AsyncFile) Write(q *AsyncQueue, offset uint64, data []byte) (<-chan AsyncResult, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	buffer := Malloc(uint64(len(data)))
+	if buffer == nil && len(data) > 0 {
+		return nil, NewError("SDL_malloc")
+	}
+	copy(unsafe.Slice((*byte)(buffer), len(data)), data)
+	return q.start("SDL_WriteAsyncIO", buffer, func(userdata unsafe.Pointer) bool {
+		return WriteAsyncIO(f.asyncio, buffer, offset, uint64(len(data)), q.queue, userdata)
+	})
+}
+
+// Close starts closing the file with [CloseAsyncIO], after the pending operations. With flush, the data is synced
+// to disk first. The result is delivered by q. The file must not be used anymore, even if an error is returned.
+func (f *AsyncFile) Close(q *AsyncQueue, flush bool) (<-chan AsyncResult, error) {
+	return q.start("SDL_CloseAsyncIO", nil, func(userdata unsafe.Pointer) bool {
+		return CloseAsyncIO(f.asyncio, flush, q.queue, userdata)
+	})
+}
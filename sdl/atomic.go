@@ -0,0 +1,100 @@
+package sdl
+
+import (
+	"sync"
+	"unsafe"
+)
+
+// The atomic types can live in C memory shared with SDL threads, e.g. the indices of an audio ring buffer:
+//
+//	indices := (*[2]sdl.AtomicU32)(sdl.Malloc(8))
+//	read := indices[0].Get()
+//
+// Unlike sync/atomic, Set and Add return the previous value, like the SDL functions.
+// A *SpinLock can be used wherever a [sync.Locker] is expected. It should only be held for a few instructions.
+var _ sync.Locker = (*SpinLock)(nil)
+
+// Get returns the value of a with [GetAtomicInt].
+func (a *AtomicInt) Get() int32 {
+	return GetAtomicInt(a)
+}
+
+// Set sets a to v with [SetAtomicInt] and returns the previous value.
+func (a *AtomicInt) Set(v int32) int32 {
+	return SetAtomicInt(a, v)
+}
+
+// Add adds v to a with [AddAtomicInt] and returns the previous value.
+func (a *AtomicInt) Add(v int32) int32 {
+	return AddAtomicInt(a, v)
+}
+
+// CompareAndSwap sets a to newval if it is oldval with [CompareAndSwapAtomicInt] and reports whether it did.
+func (a *AtomicInt) CompareAndSwap(oldval, newval int32) bool {
+	return CompareAndSwapAtomicInt(a, oldval, newval)
+}
+
+// Get returns the value of a with [GetAtomicU32].
+func (a *AtomicU32) Get() uint32 {
+	return GetAtomicU32(a)
+}
+
+// Set sets a to v with [SetAtomicU32] and returns the previous value.
+func (a *AtomicU32) Set(v uint32) uint32 {
+	return SetAtomicU32(a, v)
+}
+
+// Add adds v to a and returns the previous value. It uses [AddAtomicU32] if it is available (since SDL 3.4.0)
+// and [CompareAndSwapAtomicU32] otherwise.
+func (a *AtomicU32) Add(v int32) uint32 {
+	if sdlAddAtomicU32 != nil {
+		return sdlAddAtomicU32(a, v)
+	}
+	for {
+		old := GetAtomicU32(a)
+		if CompareAndSwapAtomicU32(a, old, old+uint32(v)) {
+			return old
+		}
+	}
+}
+
+// CompareAndSwap sets a to newval if it is oldval with [CompareAndSwapAtomicU32] and reports whether it did.
+func (a *AtomicU32) CompareAndSwap(oldval, newval uint32) bool {
+	return CompareAndSwapAtomicU32(a, oldval, newval)
+}
+
+// AtomicPointer is a pointer that is accessed atomically with [GetAtomicPointer] and its relatives.
+// The garbage collector doesn't know about pointers stored in C memory, so they must point to C memory as well.
+type AtomicPointer struct {
+	Value unsafe.Pointer
+}
+
+// Get returns the value of p with [GetAtomicPointer].
+func (p *AtomicPointer) Get() unsafe.Pointer {
+	return GetAtomicPointer(&p.Value)
+}
+
+// Set sets p to v with [SetAtomicPointer] and returns the previous value.
+func (p *AtomicPointer) Set(v unsafe.Pointer) unsafe.Pointer {
+	return SetAtomicPointer(&p.Value, v)
+}
+
+// CompareAndSwap sets p to newval if it is oldval with [CompareAndSwapAtomicPointer] and reports whether it did.
+func (p *AtomicPointer) CompareAndSwap(oldval, newval unsafe.Pointer) bool {
+	return CompareAndSwapAtomicPointer(&p.Value, oldval, newval)
+}
+
+// Lock locks the spin lock with [LockSpinlock].
+func (lock *SpinLock) Lock() {
+	LockSpinlock(lock)
+}
+
+// TryLock tries to lock the spin lock with [TryLockSpinlock] and reports whether it succeeded.
+func (lock *SpinLock) TryLock() bool {
+	return TryLockSpinlock(lock)
+}
+
+// Unlock unlocks the spin lock with [UnlockSpinlock]. Unlike a [Mutex], it may be unlocked by any goroutine.
+func (lock *SpinLock) Unlock() {
+	UnlockSpinlock(lock)
+}
@@ -0,0 +1,95 @@
+package sdl
+
+import (
+	"sync"
+	"testing"
+)
+
+const (
+	contendingGoroutines = 16
+	contendingIterations = 5000
+)
+
+// contend runs f from many goroutines at once, each calling it contendingIterations times.
+func contend(f func()) {
+	var start, done sync.WaitGroup
+	start.Add(1)
+	for g := 0; g < contendingGoroutines; g++ {
+		done.Add(1)
+		go func() {
+			defer done.Done()
+			start.Wait()
+			for i := 0; i < contendingIterations; i++ {
+				f()
+			}
+		}()
+	}
+	start.Done()
+	done.Wait()
+}
+
+func TestAtomicContention(t *testing.T) {
+	if err := LoadLibrary(); err != nil {
+		t.Skip(err)
+	}
+	const want = contendingGoroutines * contendingIterations
+
+	t.Run("AtomicInt.Add", func(t *testing.T) {
+		var a AtomicInt
+		contend(func() { a.Add(1) })
+		if got := a.Get(); got != want {
+			t.Errorf("got %d, want %d", got, want)
+		}
+	})
+
+	t.Run("AtomicInt.CompareAndSwap", func(t *testing.T) {
+		var a AtomicInt
+		contend(func() {
+			for {
+				old := a.Get()
+				if a.CompareAndSwap(old, old+1) {
+					return
+				}
+			}
+		})
+		if got := a.Get(); got != want {
+			t.Errorf("got %d, want %d", got, want)
+		}
+	})
+
+	t.Run("AtomicU32.Add", func(t *testing.T) {
+		if sdlAddAtomicU32 == nil {
+			t.Skip("SDL_AddAtomicU32 is not available")
+		}
+		var a AtomicU32
+		contend(func() { a.Add(1) })
+		if got := a.Get(); got != want {
+			t.Errorf("got %d, want %d", got, want)
+		}
+	})
+
+	t.Run("AtomicU32.Add fallback", func(t *testing.T) {
+		saved := sdlAddAtomicU32
+		sdlAddAtomicU32 = nil
+		defer func() { sdlAddAtomicU32 = saved }()
+
+		var a AtomicU32
+		contend(func() { a.Add(1) })
+		if got := a.Get(); got != want {
+			t.Errorf("got %d, want %d", got, want)
+		}
+	})
+
+	t.Run("SpinLock", func(t *testing.T) {
+		var lock SpinLock
+		var counter int
+		contend(func() {
+			lock.Lock()
+			counter++
+			lock.Unlock()
+		})
+		if counter != want {
+			t.Errorf("got %d, want %d", counter, want)
+		}
+	})
+}
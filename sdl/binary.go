@@ -0,0 +1,295 @@
+package sdl
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"runtime"
+	"unsafe"
+)
+
+// Endian is the byte order of binary data read by [ReadBinary] and written by [WriteBinary].
+type Endian int
+
+const (
+	LilEndian Endian = 1234 // Least significant byte first, like SDL_LIL_ENDIAN.
+	BigEndian Endian = 4321 // Most significant byte first, like SDL_BIG_ENDIAN.
+)
+
+// FieldError reports the value that [ReadBinary] or [WriteBinary] failed on.
+type FieldError struct {
+	Field string // Path of the value, e.g. "Header.Size" or "Tiles[3].ID". Empty for the value itself.
+	Err   error  // [io.ErrUnexpectedEOF] if the data ended early, otherwise the reason, e.g. an [*Error].
+}
+
+func (e *FieldError) Error() string {
+	if e.Field == "" {
+		return "sdl: " + e.Err.Error()
+	}
+	return "sdl: field " + e.Field + ": " + e.Err.Error()
+}
+
+// Unwrap returns Err.
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// ReadBinary reads binary data from src into data, which must be a pointer to a fixed-size value:
+// a bool, a sized integer or float, or an array, slice or struct of those.
+// Slices are filled up to their length. Bools are single bytes.
+//
+// Multi-byte values are read in the given byte order. A struct field can override it
+// with the tag `sdl:"le"` or `sdl:"be"`, which applies to everything inside of the field. Fields tagged `sdl:"-"` are skipped,
+// fields named _ are read but discarded (e.g. padding):
+//
+//	type Header struct {
+//		Magic   [4]byte
+//		Version uint16
+//		_       uint16
+//		Size    uint32 `sdl:"be"`
+//	}
+//
+//	var header Header
+//	err := sdl.ReadBinary(src, sdl.LilEndian, &header)
+//
+// It returns [io.EOF] only if src ended before any byte was read. Other failures are reported as [*FieldError],
+// which contains [io.ErrUnexpectedEOF] if src ended in the middle of data.
+func ReadBinary(src *IOStream, order Endian, data interface{}) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	v := reflect.ValueOf(data)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return fmt.Errorf("sdl: ReadBinary of non-pointer %T", data)
+	}
+	c := binaryCodec{stream: src}
+	err := c.read(v.Elem(), order, "")
+	if e, ok := err.(*FieldError); ok && e.Err == io.EOF {
+		return io.EOF
+	}
+	return err
+}
+
+// WriteBinary writes the binary representation of data to dst, see [ReadBinary]. data may also be a pointer.
+// Fields named _ are written as zeros. Failures are reported as [*FieldError].
+func WriteBinary(dst *IOStream, order Endian, data interface{}) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	v := reflect.Indirect(reflect.ValueOf(data))
+	if !v.IsValid() {
+		return errors.New("sdl: WriteBinary of nil")
+	}
+	c := binaryCodec{stream: dst}
+	return c.write(v, order, "")
+}
+
+// binaryCodec walks a value for ReadBinary or WriteBinary.
+type binaryCodec struct {
+	stream *IOStream
+	n      uint64 // bytes transferred so far
+}
+
+func (c *binaryCodec) read(v reflect.Value, order Endian, field string) error {
+	switch v.Kind() {
+	case reflect.Struct:
+		return c.fields(v, order, field, c.read)
+	case reflect.Array, reflect.Slice:
+		if v.Len() > 0 && v.Type().Elem().Kind() == reflect.Uint8 && v.Index(0).CanAddr() {
+			want := uint64(v.Len())
+			got := ReadIO(c.stream, v.Index(0).Addr().UnsafePointer(), want)
+			c.n += got
+			if got < want {
+				return c.readError("SDL_ReadIO", field)
+			}
+			return nil
+		}
+		for i := 0; i < v.Len(); i++ {
+			if err := c.read(v.Index(i), order, fmt.Sprintf("%s[%d]", field, i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	size, err := binarySize(v, field)
+	if err != nil {
+		return err
+	}
+	// Reading through a buffer instead of with SDL_ReadU32LE and its relatives counts the bytes of a value that
+	// was cut off, so that it is reported as io.ErrUnexpectedEOF.
+	var buf [8]byte
+	got := ReadIO(c.stream, unsafe.Pointer(&buf[0]), uint64(size))
+	c.n += got
+	if got < uint64(size) {
+		return c.readError("SDL_ReadIO", field)
+	}
+	var byteOrder binary.ByteOrder = binary.LittleEndian
+	if order == BigEndian {
+		byteOrder = binary.BigEndian
+	}
+	var u uint64
+	switch size {
+	case 1:
+		u = uint64(buf[0])
+	case 2:
+		u = uint64(byteOrder.Uint16(buf[:]))
+	case 4:
+		u = uint64(byteOrder.Uint32(buf[:]))
+	default:
+		u = byteOrder.Uint64(buf[:])
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		v.SetBool(u != 0)
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		shift := 64 - 8*size
+		v.SetInt(int64(u<<shift) >> shift)
+	case reflect.Float32:
+		v.SetFloat(float64(math.Float32frombits(uint32(u))))
+	case reflect.Float64:
+		v.SetFloat(math.Float64frombits(u))
+	default:
+		v.SetUint(u)
+	}
+	return nil
+}
+
+func (c *binaryCodec) write(v reflect.Value, order Endian, field string) error {
+	switch v.Kind() {
+	case reflect.Struct:
+		return c.fields(v, order, field, c.write)
+	case reflect.Array, reflect.Slice:
+		if v.Len() > 0 && v.Type().Elem().Kind() == reflect.Uint8 && v.Index(0).CanAddr() {
+			want := uint64(v.Len())
+			got := WriteIO(c.stream, v.Index(0).Addr().UnsafePointer(), want)
+			c.n += got
+			if got < want {
+				return c.writeError("SDL_WriteIO", field)
+			}
+			return nil
+		}
+		for i := 0; i < v.Len(); i++ {
+			if err := c.write(v.Index(i), order, fmt.Sprintf("%s[%d]", field, i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	size, err := binarySize(v, field)
+	if err != nil {
+		return err
+	}
+	var u uint64
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			u = 1
+		}
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		u = uint64(v.Int())
+	case reflect.Float32:
+		u = uint64(math.Float32bits(float32(v.Float())))
+	case reflect.Float64:
+		u = math.Float64bits(v.Float())
+	default:
+		u = v.Uint()
+	}
+
+	var fn string
+	var ok bool
+	switch {
+	case size == 1:
+		fn, ok = "SDL_WriteU8", WriteU8(c.stream, uint8(u))
+	case size == 2 && order == BigEndian:
+		fn, ok = "SDL_WriteU16BE", WriteU16BE(c.stream, uint16(u))
+	case size == 2:
+		fn, ok = "SDL_WriteU16LE", WriteU16LE(c.stream, uint16(u))
+	case size == 4 && order == BigEndian:
+		fn, ok = "SDL_WriteU32BE", WriteU32BE(c.stream, uint32(u))
+	case size == 4:
+		fn, ok = "SDL_WriteU32LE", WriteU32LE(c.stream, uint32(u))
+	case order == BigEndian:
+		fn, ok = "SDL_WriteU64BE", WriteU64BE(c.stream, u)
+	default:
+		fn, ok = "SDL_WriteU64LE", WriteU64LE(c.stream, u)
+	}
+	if !ok {
+		return c.writeError(fn, field)
+	}
+	c.n += uint64(size)
+	return nil
+}
+
+// fields calls walk for each field of the struct v, applying the tags.
+func (c *binaryCodec) fields(v reflect.Value, order Endian, field string, walk func(reflect.Value, Endian, string) error) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := f.Name
+		if field != "" {
+			name = field + "." + f.Name
+		}
+
+		fieldOrder := order
+		switch tag := f.Tag.Get("sdl"); tag {
+		case "-":
+			continue
+		case "le":
+			fieldOrder = LilEndian
+		case "be":
+			fieldOrder = BigEndian
+		case "":
+		default:
+			return &FieldError{Field: name, Err: fmt.Errorf("invalid tag %q", tag)}
+		}
+
+		fv := v.Field(i)
+		switch {
+		case f.Name == "_":
+			// Padding is read into a temporary value and written as zeros.
+			fv = reflect.New(f.Type).Elem()
+		case f.PkgPath != "":
+			return &FieldError{Field: name, Err: errors.New("unexported field")}
+		}
+		if err := walk(fv, fieldOrder, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readError returns the error for a failed read, telling the end of the stream apart from errors with [GetIOStatus].
+func (c *binaryCodec) readError(fn, field string) error {
+	err := ioError(fn, GetIOStatus(c.stream))
+	if err == io.EOF && c.n > 0 {
+		err = io.ErrUnexpectedEOF
+	}
+	return &FieldError{Field: field, Err: err}
+}
+
+func (c *binaryCodec) writeError(fn, field string) error {
+	err := ioError(fn, GetIOStatus(c.stream))
+	if err == io.EOF {
+		err = io.ErrShortWrite
+	}
+	return &FieldError{Field: field, Err: err}
+}
+
+// binarySize returns the size of a bool, sized integer or float value.
+func binarySize(v reflect.Value, field string) (int, error) {
+	switch v.Kind() {
+	case reflect.Bool, reflect.Int8, reflect.Uint8:
+		return 1, nil
+	case reflect.Int16, reflect.Uint16:
+		return 2, nil
+	case reflect.Int32, reflect.Uint32, reflect.Float32:
+		return 4, nil
+	case reflect.Int64, reflect.Uint64, reflect.Float64:
+		return 8, nil
+	}
+	return 0, &FieldError{Field: field, Err: errors.New("unsupported type " + v.Type().String())}
+}
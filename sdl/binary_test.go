@@ -0,0 +1,49 @@
+package sdl
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+)
+
+func TestReadBinaryEOF(t *testing.T) {
+	if err := LoadLibrary(); err != nil {
+		t.Skip(err)
+	}
+	type pair struct {
+		A uint32
+		B uint16 `sdl:"be"`
+	}
+
+	tests := []struct {
+		name    string
+		data    []byte
+		want    pair
+		wantErr error
+	}{
+		{"complete", []byte{1, 0, 0, 0, 0, 2}, pair{A: 1, B: 2}, nil},
+		{"empty", nil, pair{}, io.EOF},
+		{"first value cut off", []byte{1, 0}, pair{}, io.ErrUnexpectedEOF},
+		{"second value missing", []byte{1, 0, 0, 0}, pair{A: 1}, io.ErrUnexpectedEOF},
+		{"second value cut off", []byte{1, 0, 0, 0, 0}, pair{A: 1}, io.ErrUnexpectedEOF},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src := IOFromReader(bytes.NewReader(tt.data))
+			if src == nil {
+				t.Fatal(NewError("SDL_OpenIO"))
+			}
+			defer src.Close()
+
+			var got pair
+			err := ReadBinary(src, LilEndian, &got)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package sdl
+
+import (
+	"sync"
+	"unsafe"
+
+	"github.com/ebitengine/purego"
+)
+
+// CallbackHandle is a Go function registered with one of the shared callback trampolines.
+//
+// Functions like [NewEventFilter] create a new C function pointer on every call. The number of those is limited
+// and they are never freed. The functions returning a CallbackHandle (e.g. [AddEventWatchFunc]) use a single
+// C function pointer per callback signature instead and dispatch to the Go function through the handle.
+// SDL keeps the userdata after the call returns, so it gets an integer ID of the handle instead of a Go pointer,
+// like with runtime/cgo.Handle. They can be called any number of times, as long as the handles are released.
+type CallbackHandle struct {
+	id      uintptr
+	fn      interface{}
+	release func()
+	once    sync.Once
+}
+
+var callbacks = struct {
+	sync.RWMutex
+	handles map[uintptr]*CallbackHandle
+	next    uintptr
+}{handles: make(map[uintptr]*CallbackHandle)}
+
+// newCallbackHandle registers fn. The optional release function is called by [CallbackHandle.Release]
+// and must make sure that SDL won't call the trampoline with this handle anymore.
+func newCallbackHandle(fn interface{}, release func()) *CallbackHandle {
+	h := &CallbackHandle{fn: fn, release: release}
+	callbacks.Lock()
+	callbacks.next++
+	h.id = callbacks.next
+	callbacks.handles[h.id] = h
+	callbacks.Unlock()
+	return h
+}
+
+// lookupCallback returns the Go function registered for the userdata passed to a trampoline or nil.
+func lookupCallback(userdata uintptr) interface{} {
+	callbacks.RLock()
+	h := callbacks.handles[userdata]
+	callbacks.RUnlock()
+	if h == nil {
+		return nil
+	}
+	return h.fn
+}
+
+// releaseCallback releases the handle for the userdata passed to a trampoline, if it still exists.
+func releaseCallback(userdata uintptr) {
+	callbacks.RLock()
+	h := callbacks.handles[userdata]
+	callbacks.RUnlock()
+	h.Release()
+}
+
+// Release unregisters the function from SDL, if it was installed by the function returning the handle,
+// and frees the handle. It is safe to call Release multiple times or on a nil handle.
+func (h *CallbackHandle) Release() {
+	if h == nil {
+		return
+	}
+	h.once.Do(func() {
+		if h.release != nil {
+			h.release()
+		}
+		callbacks.Lock()
+		delete(callbacks.handles, h.userdata())
+		callbacks.Unlock()
+	})
+}
+
+func (h *CallbackHandle) userdata() uintptr {
+	return h.id
+}
+
+func (h *CallbackHandle) pointer() unsafe.Pointer {
+	return handlePointer(h.id)
+}
+
+// handlePointer converts a handle ID into the userdata pointer taken by SDL. It never points to memory,
+// the trampolines only use it to look up the handle.
+func handlePointer(id uintptr) unsafe.Pointer {
+	return *(*unsafe.Pointer)(unsafe.Pointer(&id))
+}
+
+// trampoline is a C function pointer created on first use and shared by all handles of the same signature.
+type trampoline struct {
+	once sync.Once
+	ptr  uintptr
+}
+
+func (t *trampoline) get(fn interface{}) uintptr {
+	t.once.Do(func() {
+		t.ptr = purego.NewCallback(fn)
+	})
+	return t.ptr
+}
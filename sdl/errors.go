@@ -0,0 +1,574 @@
+package sdl
+
+import (
+	"errors"
+	"runtime"
+	"unsafe"
+
+	"github.com/jupiterrider/purego-sdl3/internal/shared"
+)
+
+// ErrSDL is wrapped by every error returned from the error-returning functions (the ones with the Err suffix)
+// of the packages sdl, img and ttf. Use [errors.Is] to test for it.
+var ErrSDL = errors.New("sdl: call failed")
+
+// ErrNotAvailable matches errors of functions, which are missing in the loaded library version. See [HasFunction].
+var ErrNotAvailable = errors.New("sdl: function not available")
+
+// Error describes a failed call into one of the SDL libraries.
+type Error struct {
+	Func    string // Name of the failing C function, e.g. "SDL_Init".
+	Message string // Message reported by [GetError] right after the failure.
+
+	notAvailable bool
+}
+
+func (e *Error) Error() string {
+	if e.Message == "" {
+		return e.Func + " failed"
+	}
+	return e.Func + ": " + e.Message
+}
+
+// Unwrap returns [ErrSDL].
+func (e *Error) Unwrap() error {
+	return ErrSDL
+}
+
+// Is reports whether target is [ErrNotAvailable] and the function is missing in the loaded library.
+func (e *Error) Is(target error) bool {
+	return target == ErrNotAvailable && e.notAvailable
+}
+
+// NewError returns an [*Error] for the C function fn, carrying the current message of [GetError].
+//
+// It must be called on the same thread as the failing function, because SDL stores error messages per thread.
+// Goroutines move between threads, so unless the goroutine is locked to its thread already (like the one running
+// [Main]), lock it with [runtime.LockOSThread] around the failing call and NewError. The functions of the packages
+// sdl, img and ttf returning an error, like the ones with the Err suffix, do that themselves.
+func NewError(fn string) error {
+	return &Error{Func: fn, Message: GetError(), notAvailable: shared.Missing(fn)}
+}
+
+// Check returns nil if ok is true and [NewError] for fn otherwise.
+//
+// It converts the result of any function not covered by an Err variant. Like with [NewError], the goroutine
+// must stay on the thread of the failing call:
+//
+//	runtime.LockOSThread()
+//	defer runtime.UnlockOSThread()
+//	if err := sdl.Check("SDL_FlashWindow", sdl.FlashWindow(window, sdl.FlashBriefly)); err != nil {
+//		return err
+//	}
+func Check(fn string, ok bool) error {
+	if ok {
+		return nil
+	}
+	return NewError(fn)
+}
+
+// notAvailable sets the error message for the C function fn, which the loaded library doesn't provide.
+func notAvailable(fn string) {
+	if sdlSetError == nil {
+		return
+	}
+	if version, ok := functionVersions[fn]; ok {
+		SetError("%s is not available in this SDL version (requires %s)", fn, version)
+	} else {
+		SetError("%s is not available in this SDL version", fn)
+	}
+}
+
+// InitErr is like [Init], but returns an error on failure.
+func InitErr(flags InitFlags) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return Check("SDL_Init", Init(flags))
+}
+
+// InitSubSystemErr is like [InitSubSystem], but returns an error on failure.
+func InitSubSystemErr(flags InitFlags) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return Check("SDL_InitSubSystem", InitSubSystem(flags))
+}
+
+// SetHintErr is like [SetHint], but returns an error on failure.
+func SetHintErr(name, value string) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return Check("SDL_SetHint", SetHint(name, value))
+}
+
+// CreateWindowErr is like [CreateWindow], but returns an error on failure.
+func CreateWindowErr(title string, w int32, h int32, flags WindowFlags) (*Window, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	window := CreateWindow(title, w, h, flags)
+	if window == nil {
+		return nil, NewError("SDL_CreateWindow")
+	}
+	return window, nil
+}
+
+// CreateWindowWithPropertiesErr is like [CreateWindowWithProperties], but returns an error on failure.
+func CreateWindowWithPropertiesErr(props PropertiesID) (*Window, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	window := CreateWindowWithProperties(props)
+	if window == nil {
+		return nil, NewError("SDL_CreateWindowWithProperties")
+	}
+	return window, nil
+}
+
+// CreateWindowAndRendererErr is like [CreateWindowAndRenderer], but returns the window and renderer or an error.
+func CreateWindowAndRendererErr(title string, width, height int32, flags WindowFlags) (*Window, *Renderer, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	var window *Window
+	var renderer *Renderer
+	if !CreateWindowAndRenderer(title, width, height, flags, &window, &renderer) {
+		return nil, nil, NewError("SDL_CreateWindowAndRenderer")
+	}
+	return window, renderer, nil
+}
+
+// GetWindowSizeErr is like [GetWindowSize], but returns the size or an error.
+func GetWindowSizeErr(window *Window) (w, h int32, err error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	if !GetWindowSize(window, &w, &h) {
+		return 0, 0, NewError("SDL_GetWindowSize")
+	}
+	return w, h, nil
+}
+
+// SetWindowTitleErr is like [SetWindowTitle], but returns an error on failure.
+func SetWindowTitleErr(window *Window, title string) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return Check("SDL_SetWindowTitle", SetWindowTitle(window, title))
+}
+
+// SetWindowSizeErr is like [SetWindowSize], but returns an error on failure.
+func SetWindowSizeErr(window *Window, w int32, h int32) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return Check("SDL_SetWindowSize", SetWindowSize(window, w, h))
+}
+
+// SetWindowPositionErr is like [SetWindowPosition], but returns an error on failure.
+func SetWindowPositionErr(window *Window, x int32, y int32) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return Check("SDL_SetWindowPosition", SetWindowPosition(window, x, y))
+}
+
+// SetWindowFullscreenErr is like [SetWindowFullscreen], but returns an error on failure.
+func SetWindowFullscreenErr(window *Window, fullscreen bool) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return Check("SDL_SetWindowFullscreen", SetWindowFullscreen(window, fullscreen))
+}
+
+// SetWindowRelativeMouseModeErr is like [SetWindowRelativeMouseMode], but returns an error on failure.
+func SetWindowRelativeMouseModeErr(window *Window, enabled bool) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return Check("SDL_SetWindowRelativeMouseMode", SetWindowRelativeMouseMode(window, enabled))
+}
+
+// ShowWindowErr is like [ShowWindow], but returns an error on failure.
+func ShowWindowErr(window *Window) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return Check("SDL_ShowWindow", ShowWindow(window))
+}
+
+// HideWindowErr is like [HideWindow], but returns an error on failure.
+func HideWindowErr(window *Window) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return Check("SDL_HideWindow", HideWindow(window))
+}
+
+// CreateRendererErr is like [CreateRenderer], but returns an error on failure.
+func CreateRendererErr(window *Window, name string) (*Renderer, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	renderer := CreateRenderer(window, name)
+	if renderer == nil {
+		return nil, NewError("SDL_CreateRenderer")
+	}
+	return renderer, nil
+}
+
+// CreateSoftwareRendererErr is like [CreateSoftwareRenderer], but returns an error on failure.
+func CreateSoftwareRendererErr(surface *Surface) (*Renderer, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	renderer := CreateSoftwareRenderer(surface)
+	if renderer == nil {
+		return nil, NewError("SDL_CreateSoftwareRenderer")
+	}
+	return renderer, nil
+}
+
+// GetRenderOutputSizeErr is like [GetRenderOutputSize], but returns the size or an error.
+func GetRenderOutputSizeErr(renderer *Renderer) (w, h int32, err error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	if !GetRenderOutputSize(renderer, &w, &h) {
+		return 0, 0, NewError("SDL_GetRenderOutputSize")
+	}
+	return w, h, nil
+}
+
+// SetRenderDrawColorErr is like [SetRenderDrawColor], but returns an error on failure.
+func SetRenderDrawColorErr(renderer *Renderer, r, g, b, a uint8) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return Check("SDL_SetRenderDrawColor", SetRenderDrawColor(renderer, r, g, b, a))
+}
+
+// SetRenderDrawColorFloatErr is like [SetRenderDrawColorFloat], but returns an error on failure.
+func SetRenderDrawColorFloatErr(renderer *Renderer, r, g, b, a float32) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return Check("SDL_SetRenderDrawColorFloat", SetRenderDrawColorFloat(renderer, r, g, b, a))
+}
+
+// SetRenderDrawBlendModeErr is like [SetRenderDrawBlendMode], but returns an error on failure.
+func SetRenderDrawBlendModeErr(renderer *Renderer, blendMode BlendMode) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return Check("SDL_SetRenderDrawBlendMode", SetRenderDrawBlendMode(renderer, blendMode))
+}
+
+// SetRenderLogicalPresentationErr is like [SetRenderLogicalPresentation], but returns an error on failure.
+func SetRenderLogicalPresentationErr(renderer *Renderer, w int32, h int32, mode RendererLogicalPresentation) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return Check("SDL_SetRenderLogicalPresentation", SetRenderLogicalPresentation(renderer, w, h, mode))
+}
+
+// SetRenderScaleErr is like [SetRenderScale], but returns an error on failure.
+func SetRenderScaleErr(renderer *Renderer, scaleX, scaleY float32) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return Check("SDL_SetRenderScale", SetRenderScale(renderer, scaleX, scaleY))
+}
+
+// SetRenderTargetErr is like [SetRenderTarget], but returns an error on failure.
+func SetRenderTargetErr(renderer *Renderer, texture *Texture) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return Check("SDL_SetRenderTarget", SetRenderTarget(renderer, texture))
+}
+
+// SetRenderViewportErr is like [SetRenderViewport], but returns an error on failure.
+func SetRenderViewportErr(renderer *Renderer, rect *Rect) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return Check("SDL_SetRenderViewport", SetRenderViewport(renderer, rect))
+}
+
+// SetRenderClipRectErr is like [SetRenderClipRect], but returns an error on failure.
+func SetRenderClipRectErr(renderer *Renderer, rect *Rect) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return Check("SDL_SetRenderClipRect", SetRenderClipRect(renderer, rect))
+}
+
+// SetRenderVSyncErr is like [SetRenderVSync], but returns an error on failure.
+func SetRenderVSyncErr(renderer *Renderer, vsync int32) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return Check("SDL_SetRenderVSync", SetRenderVSync(renderer, vsync))
+}
+
+// RenderClearErr is like [RenderClear], but returns an error on failure.
+func RenderClearErr(renderer *Renderer) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return Check("SDL_RenderClear", RenderClear(renderer))
+}
+
+// RenderPresentErr is like [RenderPresent], but returns an error on failure.
+func RenderPresentErr(renderer *Renderer) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return Check("SDL_RenderPresent", RenderPresent(renderer))
+}
+
+// RenderLineErr is like [RenderLine], but returns an error on failure.
+func RenderLineErr(renderer *Renderer, x1, y1, x2, y2 float32) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return Check("SDL_RenderLine", RenderLine(renderer, x1, y1, x2, y2))
+}
+
+// RenderRectErr is like [RenderRect], but returns an error on failure.
+func RenderRectErr(renderer *Renderer, rect *FRect) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return Check("SDL_RenderRect", RenderRect(renderer, rect))
+}
+
+// RenderFillRectErr is like [RenderFillRect], but returns an error on failure.
+func RenderFillRectErr(renderer *Renderer, rect *FRect) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return Check("SDL_RenderFillRect", RenderFillRect(renderer, rect))
+}
+
+// RenderTextureErr is like [RenderTexture], but returns an error on failure.
+func RenderTextureErr(renderer *Renderer, texture *Texture, srcrect *FRect, dstrect *FRect) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return Check("SDL_RenderTexture", RenderTexture(renderer, texture, srcrect, dstrect))
+}
+
+// RenderTextureRotatedErr is like [RenderTextureRotated], but returns an error on failure.
+func RenderTextureRotatedErr(renderer *Renderer, texture *Texture, srcrect *FRect, dstrect *FRect, angle float64, center *FPoint, flip FlipMode) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return Check("SDL_RenderTextureRotated", RenderTextureRotated(renderer, texture, srcrect, dstrect, angle, center, flip))
+}
+
+// RenderReadPixelsErr is like [RenderReadPixels], but returns an error on failure.
+func RenderReadPixelsErr(renderer *Renderer, rect *Rect) (*Surface, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	surface := RenderReadPixels(renderer, rect)
+	if surface == nil {
+		return nil, NewError("SDL_RenderReadPixels")
+	}
+	return surface, nil
+}
+
+// CreateTextureErr is like [CreateTexture], but returns an error on failure.
+func CreateTextureErr(renderer *Renderer, format PixelFormat, access TextureAccess, w int32, h int32) (*Texture, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	texture := CreateTexture(renderer, format, access, w, h)
+	if texture == nil {
+		return nil, NewError("SDL_CreateTexture")
+	}
+	return texture, nil
+}
+
+// CreateTextureFromSurfaceErr is like [CreateTextureFromSurface], but returns an error on failure.
+func CreateTextureFromSurfaceErr(renderer *Renderer, surface *Surface) (*Texture, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	texture := CreateTextureFromSurface(renderer, surface)
+	if texture == nil {
+		return nil, NewError("SDL_CreateTextureFromSurface")
+	}
+	return texture, nil
+}
+
+// GetTextureSizeErr is like [GetTextureSize], but returns the size or an error.
+func GetTextureSizeErr(texture *Texture) (w, h float32, err error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	if !GetTextureSize(texture, &w, &h) {
+		return 0, 0, NewError("SDL_GetTextureSize")
+	}
+	return w, h, nil
+}
+
+// SetTextureBlendModeErr is like [SetTextureBlendMode], but returns an error on failure.
+func SetTextureBlendModeErr(texture *Texture, blendMode BlendMode) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return Check("SDL_SetTextureBlendMode", SetTextureBlendMode(texture, blendMode))
+}
+
+// UpdateTextureErr is like [UpdateTexture], but returns an error on failure.
+func UpdateTextureErr(texture *Texture, rect *Rect, pixels unsafe.Pointer, pitch int32) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return Check("SDL_UpdateTexture", UpdateTexture(texture, rect, pixels, pitch))
+}
+
+// CreateSurfaceErr is like [CreateSurface], but returns an error on failure.
+func CreateSurfaceErr(width int32, height int32, format PixelFormat) (*Surface, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	surface := CreateSurface(width, height, format)
+	if surface == nil {
+		return nil, NewError("SDL_CreateSurface")
+	}
+	return surface, nil
+}
+
+// ConvertSurfaceErr is like [ConvertSurface], but returns an error on failure.
+func ConvertSurfaceErr(surface *Surface, format PixelFormat) (*Surface, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	converted := ConvertSurface(surface, format)
+	if converted == nil {
+		return nil, NewError("SDL_ConvertSurface")
+	}
+	return converted, nil
+}
+
+// DuplicateSurfaceErr is like [DuplicateSurface], but returns an error on failure.
+func DuplicateSurfaceErr(surface *Surface) (*Surface, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	duplicate := DuplicateSurface(surface)
+	if duplicate == nil {
+		return nil, NewError("SDL_DuplicateSurface")
+	}
+	return duplicate, nil
+}
+
+// ScaleSurfaceErr is like [ScaleSurface], but returns an error on failure.
+func ScaleSurfaceErr(surface *Surface, width int32, height int32, scaleMode ScaleMode) (*Surface, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	scaled := ScaleSurface(surface, width, height, scaleMode)
+	if scaled == nil {
+		return nil, NewError("SDL_ScaleSurface")
+	}
+	return scaled, nil
+}
+
+// LoadBMPErr is like [LoadBMP], but returns an error on failure.
+func LoadBMPErr(file string) (*Surface, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	surface := LoadBMP(file)
+	if surface == nil {
+		return nil, NewError("SDL_LoadBMP")
+	}
+	return surface, nil
+}
+
+// SaveBMPErr is like [SaveBMP], but returns an error on failure.
+func SaveBMPErr(surface *Surface, file string) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return Check("SDL_SaveBMP", SaveBMP(surface, file))
+}
+
+// BlitSurfaceErr is like [BlitSurface], but returns an error on failure.
+func BlitSurfaceErr(src *Surface, srcrect *Rect, dst *Surface, dstrect *Rect) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return Check("SDL_BlitSurface", BlitSurface(src, srcrect, dst, dstrect))
+}
+
+// FillSurfaceRectErr is like [FillSurfaceRect], but returns an error on failure.
+func FillSurfaceRectErr(dst *Surface, rect *Rect, color uint32) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return Check("SDL_FillSurfaceRect", FillSurfaceRect(dst, rect, color))
+}
+
+// LockSurfaceErr is like [LockSurface], but returns an error on failure.
+func LockSurfaceErr(surface *Surface) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return Check("SDL_LockSurface", LockSurface(surface))
+}
+
+// LoadWAVErr is like [LoadWAV], but returns an error on failure.
+func LoadWAVErr(path string, spec *AudioSpec, audioBuf **uint8, audioLen *uint32) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return Check("SDL_LoadWAV", LoadWAV(path, spec, audioBuf, audioLen))
+}
+
+// OpenAudioDeviceStreamErr is like [OpenAudioDeviceStream], but returns an error on failure.
+func OpenAudioDeviceStreamErr(devid AudioDeviceID, spec *AudioSpec, callback AudioStreamCallback, userdata unsafe.Pointer) (*AudioStream, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	stream := OpenAudioDeviceStream(devid, spec, callback, userdata)
+	if stream == nil {
+		return nil, NewError("SDL_OpenAudioDeviceStream")
+	}
+	return stream, nil
+}
+
+// PutAudioStreamDataErr is like [PutAudioStreamData], but returns an error on failure.
+func PutAudioStreamDataErr(stream *AudioStream, buf *uint8, len int32) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return Check("SDL_PutAudioStreamData", PutAudioStreamData(stream, buf, len))
+}
+
+// ResumeAudioStreamDeviceErr is like [ResumeAudioStreamDevice], but returns an error on failure.
+func ResumeAudioStreamDeviceErr(stream *AudioStream) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return Check("SDL_ResumeAudioStreamDevice", ResumeAudioStreamDevice(stream))
+}
+
+// PushEventErr is like [PushEvent], but returns an error if the event was not pushed.
+func PushEventErr(event *Event) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return Check("SDL_PushEvent", PushEvent(event))
+}
+
+// WaitEventErr is like [WaitEvent], but returns an error on failure.
+func WaitEventErr(event *Event) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return Check("SDL_WaitEvent", WaitEvent(event))
+}
+
+// IOFromFileErr is like [IOFromFile], but returns an error on failure.
+func IOFromFileErr(file string, mode string) (*IOStream, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	stream := IOFromFile(file, mode)
+	if stream == nil {
+		return nil, NewError("SDL_IOFromFile")
+	}
+	return stream, nil
+}
+
+// CloseIOErr is like [CloseIO], but returns an error on failure.
+func CloseIOErr(context *IOStream) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return Check("SDL_CloseIO", CloseIO(context))
+}
+
+// CreateGPUDeviceErr is like [CreateGPUDevice], but returns an error on failure.
+func CreateGPUDeviceErr(formatFlags GPUShaderFormat, debugMode bool, name string) (*GPUDevice, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	device := CreateGPUDevice(formatFlags, debugMode, name)
+	if device == nil {
+		return nil, NewError("SDL_CreateGPUDevice")
+	}
+	return device, nil
+}
+
+// ClaimWindowForGPUDeviceErr is like [ClaimWindowForGPUDevice], but returns an error on failure.
+func ClaimWindowForGPUDeviceErr(device *GPUDevice, window *Window) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return Check("SDL_ClaimWindowForGPUDevice", ClaimWindowForGPUDevice(device, window))
+}
+
+// ShowSimpleMessageBoxErr is like [ShowSimpleMessageBox], but returns an error on failure.
+func ShowSimpleMessageBoxErr(flags MessageBoxFlags, title string, message string, window *Window) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return Check("SDL_ShowSimpleMessageBox", ShowSimpleMessageBox(flags, title, message, window))
+}
+
+// OpenURLErr is like [OpenURL], but returns an error on failure.
+func OpenURLErr(url string) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return Check("SDL_OpenURL", OpenURL(url))
+}
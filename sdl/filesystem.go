@@ -0,0 +1,63 @@
+package sdl
+
+import (
+	"io/fs"
+	"path/filepath"
+	"runtime"
+	"time"
+	"unsafe"
+
+	"github.com/jupiterrider/purego-sdl3/internal/convert"
+)
+
+// Glob returns the paths below path matching pattern, see [GlobDirectory]. The paths are relative to path
+// and the list allocated by SDL is freed. An empty pattern matches everything.
+func Glob(path, pattern string, flags GlobFlags) ([]string, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	var count int32
+	list := GlobDirectory(path, pattern, flags, &count)
+	if list == nil {
+		return nil, NewError("SDL_GlobDirectory")
+	}
+	defer Free(unsafe.Pointer(list))
+	return convert.ToStringSlice(list), nil
+}
+
+// Stat returns information about path with [GetPathInfo]. Symlinks are followed.
+// SDL doesn't tell missing paths apart from other failures, so they are all reported as [fs.ErrNotExist].
+func Stat(path string) (fs.FileInfo, error) {
+	var info PathInfo
+	if !GetPathInfo(path, &info) || info.Type == PathTypeNone {
+		return nil, &fs.PathError{Op: "stat", Path: path, Err: fs.ErrNotExist}
+	}
+	return &pathFileInfo{name: filepath.Base(path), info: info}, nil
+}
+
+// Created returns CreateTime as [time.Time].
+func (info *PathInfo) Created() time.Time {
+	return info.CreateTime.GoTime()
+}
+
+// Modified returns ModifyTime as [time.Time].
+func (info *PathInfo) Modified() time.Time {
+	return info.ModifyTime.GoTime()
+}
+
+// Accessed returns AccessTime as [time.Time].
+func (info *PathInfo) Accessed() time.Time {
+	return info.AccessTime.GoTime()
+}
+
+// Mode returns the type of the path as [fs.FileMode]. SDL doesn't report permissions, so they are made up:
+// 0o755 for directories and 0o644 for everything else.
+func (info *PathInfo) Mode() fs.FileMode {
+	switch info.Type {
+	case PathTypeDirectory:
+		return fs.ModeDir | 0o755
+	case PathTypeOther:
+		return fs.ModeIrregular | 0o644
+	default:
+		return 0o644
+	}
+}
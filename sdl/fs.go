@@ -0,0 +1,72 @@
+package sdl
+
+import (
+	"bytes"
+	"io"
+	"io/fs"
+	"runtime"
+)
+
+// IOFromFS opens the file name of fsys as a read-only [IOStream], e.g. to load assets from an [embed.FS]:
+//
+//	//go:embed assets
+//	var assets embed.FS
+//
+//	stream, err := sdl.IOFromFS(assets, "assets/music.wav")
+//
+// Files implementing [io.Seeker] are streamed with [IOFromReader]. Others, like the ones of [archive/zip],
+// are read into memory, because SDL needs to seek. Closing the stream closes the file.
+func IOFromFS(fsys fs.FS, name string) (*IOStream, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	f, err := fsys.Open(name)
+	if err != nil {
+		return nil, err
+	}
+
+	var r io.Reader = f
+	if _, ok := f.(io.Seeker); !ok {
+		data, err := io.ReadAll(f)
+		f.Close()
+		if err != nil {
+			return nil, &fs.PathError{Op: "read", Path: name, Err: err}
+		}
+		r = bytes.NewReader(data)
+	}
+
+	stream := IOFromReader(r)
+	if stream == nil {
+		if c, ok := r.(io.Closer); ok {
+			c.Close()
+		}
+		return nil, NewError("SDL_OpenIO")
+	}
+	return stream, nil
+}
+
+// LoadBMPFS is like [LoadBMP], but loads the file name of fsys. See [IOFromFS].
+func LoadBMPFS(fsys fs.FS, name string) (*Surface, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	src, err := IOFromFS(fsys, name)
+	if err != nil {
+		return nil, err
+	}
+	surface := LoadBMPIO(src, true)
+	if surface == nil {
+		return nil, NewError("SDL_LoadBMP_IO")
+	}
+	return surface, nil
+}
+
+// LoadWAVFS is like [LoadWAV], but loads the file name of fsys. See [IOFromFS].
+// The audio data must be freed with [Free].
+func LoadWAVFS(fsys fs.FS, name string, spec *AudioSpec, audioBuf **uint8, audioLen *uint32) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	src, err := IOFromFS(fsys, name)
+	if err != nil {
+		return err
+	}
+	return Check("SDL_LoadWAV_IO", LoadWAVIO(src, true, spec, audioBuf, audioLen))
+}
@@ -0,0 +1,344 @@
+package sdl
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// Hint is a typed descriptor of the hint Name, which validates values before they are passed to SDL:
+//
+//	vsync := sdl.IntHint(sdl.HintRenderVSync, -1, 4)
+//	previous, err := vsync.Set(1)
+//
+//	if _, err := sdl.VideoDriverHint.Set([]string{"wayland", "x11"}); err != nil {
+//		// e.g. sdl: hint SDL_VIDEO_DRIVER: invalid value "wayland,x11": unknown driver "wayland", available are ["x11" "offscreen" "dummy"]
+//	}
+//
+// Use [BoolHint], [IntHint], [EnumHint] or [DriverListHint] to describe a hint.
+type Hint[T any] struct {
+	Name   string
+	parse  func(value string) (T, error)
+	format func(value T) string
+}
+
+// HintValidator validates the values of a hint, see [LoadHints]. It is implemented by [Hint].
+type HintValidator interface {
+	HintName() string
+	Validate(value string) error
+}
+
+// HintError reports an invalid hint value.
+type HintError struct {
+	Name  string // The name of the hint.
+	Value string // The invalid value.
+	Err   error  // The reason.
+}
+
+func (e *HintError) Error() string {
+	return fmt.Sprintf("sdl: hint %s: invalid value %q: %v", e.Name, e.Value, e.Err)
+}
+
+// Unwrap returns Err.
+func (e *HintError) Unwrap() error {
+	return e.Err
+}
+
+// Typed descriptors of the driver hints. Their values are validated against the drivers compiled into SDL.
+var (
+	AudioDriverHint  = DriverListHint(HintAudioDriver, func() []string { return driverNames(GetNumAudioDrivers, GetAudioDriver) })
+	RenderDriverHint = DriverListHint(HintRenderDriver, func() []string { return driverNames(GetNumRenderDrivers, GetRenderDriver) })
+	VideoDriverHint  = DriverListHint(HintVideoDriver, func() []string { return driverNames(GetNumVideoDrivers, GetVideoDriver) })
+)
+
+// knownHints are the descriptors used by [LoadHints] in addition to the ones passed to it.
+var knownHints = []HintValidator{AudioDriverHint, RenderDriverHint, VideoDriverHint}
+
+// BoolHint describes a hint that is either enabled ("1") or disabled ("0"). Like SDL, it also accepts "true" and "false".
+func BoolHint(name string) Hint[bool] {
+	return Hint[bool]{
+		Name: name,
+		parse: func(value string) (bool, error) {
+			switch strings.ToLower(value) {
+			case "1", "true":
+				return true, nil
+			case "0", "false":
+				return false, nil
+			}
+			return false, errors.New(`want "0" or "1"`)
+		},
+		format: func(value bool) string {
+			if value {
+				return "1"
+			}
+			return "0"
+		},
+	}
+}
+
+// IntHint describes a hint that takes an integer in the range [min, max].
+func IntHint(name string, min, max int) Hint[int] {
+	return Hint[int]{
+		Name: name,
+		parse: func(value string) (int, error) {
+			i, err := strconv.Atoi(value)
+			if err != nil {
+				return 0, errors.New("not an integer")
+			}
+			if i < min || i > max {
+				return 0, fmt.Errorf("out of range [%d, %d]", min, max)
+			}
+			return i, nil
+		},
+		format: strconv.Itoa,
+	}
+}
+
+// EnumHint describes a hint that takes one of the given values.
+func EnumHint(name string, values ...string) Hint[string] {
+	return Hint[string]{
+		Name: name,
+		parse: func(value string) (string, error) {
+			for _, v := range values {
+				if value == v {
+					return value, nil
+				}
+			}
+			return "", fmt.Errorf("want one of %q", values)
+		},
+		format: func(value string) string { return value },
+	}
+}
+
+// DriverListHint describes a hint that takes a comma-separated list of driver names, which are tried in order.
+// The names are compared case-insensitively with the ones returned by drivers, unless it is nil.
+func DriverListHint(name string, drivers func() []string) Hint[[]string] {
+	return Hint[[]string]{
+		Name: name,
+		parse: func(value string) ([]string, error) {
+			list := strings.Split(value, ",")
+			var available []string
+			if drivers != nil {
+				available = drivers()
+			}
+			for _, driver := range list {
+				if driver == "" {
+					return nil, errors.New("empty driver name")
+				}
+				if drivers != nil && !containsFold(available, driver) {
+					return nil, fmt.Errorf("unknown driver %q, available are %q", driver, available)
+				}
+			}
+			return list, nil
+		},
+		format: func(value []string) string { return strings.Join(value, ",") },
+	}
+}
+
+// HintName returns the name of the hint.
+func (h Hint[T]) HintName() string {
+	return h.Name
+}
+
+// Validate reports whether value is valid for the hint with a [*HintError].
+func (h Hint[T]) Validate(value string) error {
+	_, err := h.parseValue(value)
+	return err
+}
+
+// Get returns the current value of the hint with [GetHint]. It reports false if the hint isn't set or invalid.
+func (h Hint[T]) Get() (T, bool) {
+	value, err := h.parseValue(GetHint(h.Name))
+	return value, err == nil
+}
+
+// Set sets the hint with normal priority and returns the previous value, which is the zero value
+// if the hint wasn't set or invalid. See [Hint.SetWithPriority].
+func (h Hint[T]) Set(value T) (T, error) {
+	return h.SetWithPriority(value, HintNormal)
+}
+
+// SetWithPriority validates value and sets the hint with [SetHintWithPriority]. It returns the previous value,
+// which is the zero value if the hint wasn't set or invalid.
+func (h Hint[T]) SetWithPriority(value T, priority HintPriority) (T, error) {
+	previous, _ := h.Get()
+	s := h.format(value)
+	if _, err := h.parseValue(s); err != nil {
+		return previous, err
+	}
+	return previous, setHint(h.Name, s, priority)
+}
+
+// Reset resets the hint to its default value with [ResetHint].
+func (h Hint[T]) Reset() error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return Check("SDL_ResetHint", ResetHint(h.Name))
+}
+
+// OnChange calls callback with the old and the new value whenever the hint changes, using [AddHintCallbackFunc].
+// It is called immediately with the current value. Invalid values are skipped and the ones of a hint that isn't set
+// are the zero value. Release the returned handle to stop the notifications.
+func (h Hint[T]) OnChange(callback func(oldValue, newValue T)) (*CallbackHandle, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	handle, ok := AddHintCallbackFunc(h.Name, func(name, oldValue, newValue string) {
+		var o, n T
+		var err error
+		if oldValue != "" {
+			o, _ = h.parse(oldValue)
+		}
+		if newValue != "" {
+			if n, err = h.parse(newValue); err != nil {
+				return
+			}
+		}
+		callback(o, n)
+	})
+	if !ok {
+		return nil, NewError("SDL_AddHintCallback")
+	}
+	return handle, nil
+}
+
+// Notify sends the new value to c whenever the hint changes, like [Hint.OnChange]. Like os/signal.Notify,
+// it doesn't block sending to c, so values are dropped if c isn't ready. Release the returned handle to stop
+// the notifications.
+func (h Hint[T]) Notify(c chan<- T) (*CallbackHandle, error) {
+	return h.OnChange(func(_, newValue T) {
+		select {
+		case c <- newValue:
+		default:
+		}
+	})
+}
+
+func (h Hint[T]) parseValue(value string) (T, error) {
+	v, err := h.parse(value)
+	if err != nil {
+		return v, &HintError{Name: h.Name, Value: value, Err: err}
+	}
+	return v, nil
+}
+
+// LoadHints sets the hints read from r with [SetHintWithPriority], e.g. from a config file:
+//
+//	# comments and empty lines are ignored
+//	SDL_VIDEO_DRIVER = x11
+//	SDL_RENDER_VSYNC = 1
+//
+// Values of [AudioDriverHint], [RenderDriverHint], [VideoDriverHint] and the given hints are validated first.
+// The driver hints are only validated if the SDL library can be loaded. Nothing is set if a line is invalid.
+// The hints are set in order, so if SDL refuses one, e.g. because it is overridden with a higher priority,
+// the error is returned and the hints before it stay set.
+func LoadHints(r io.Reader, priority HintPriority, hints ...HintValidator) error {
+	var names, values []string
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		name, value, ok := strings.Cut(text, "=")
+		name, value = strings.TrimSpace(name), strings.TrimSpace(value)
+		if !ok || name == "" {
+			return fmt.Errorf("sdl: hints line %d: want name = value", line)
+		}
+		if err := validateHint(name, value, hints); err != nil {
+			return fmt.Errorf("%w (line %d)", err, line)
+		}
+		names, values = append(names, name), append(values, value)
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	return setHints(names, values, priority)
+}
+
+// LoadHintsFromEnv sets the hints of the environment variables starting with prefix, validated like [LoadHints].
+// The name of the hint is the rest of the variable name, e.g. with the prefix "MYGAME_" the variable
+// MYGAME_SDL_VIDEO_DRIVER sets [HintVideoDriver]. SDL already reads unprefixed variables like SDL_VIDEO_DRIVER itself,
+// but they can't be validated or given a priority.
+func LoadHintsFromEnv(prefix string, priority HintPriority, hints ...HintValidator) error {
+	var names, values []string
+	for _, env := range os.Environ() {
+		key, value, _ := strings.Cut(env, "=")
+		if !strings.HasPrefix(key, prefix) || len(key) == len(prefix) {
+			continue
+		}
+		name := key[len(prefix):]
+		if err := validateHint(name, value, hints); err != nil {
+			return fmt.Errorf("%w (environment variable %s)", err, key)
+		}
+		names, values = append(names, name), append(values, value)
+	}
+	return setHints(names, values, priority)
+}
+
+func validateHint(name, value string, hints []HintValidator) error {
+	lists := [][]HintValidator{hints, knownHints}
+	if LoadLibrary() != nil {
+		// the drivers can't be queried
+		lists = lists[:1]
+	}
+	for _, list := range lists {
+		for _, h := range list {
+			if h.HintName() == name {
+				return h.Validate(value)
+			}
+		}
+	}
+	return nil
+}
+
+func setHints(names, values []string, priority HintPriority) error {
+	if len(names) == 0 {
+		return nil
+	}
+	if err := LoadLibrary(); err != nil {
+		return err
+	}
+	for i, name := range names {
+		if err := setHint(name, values[i], priority); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setHint sets a hint with [SetHintWithPriority]. SDL doesn't set an error if the hint is overridden with
+// a higher priority, so a message is made up in that case.
+func setHint(name, value string, priority HintPriority) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	ClearError()
+	if !SetHintWithPriority(name, value, priority) {
+		if msg := GetError(); msg != "" {
+			return &Error{Func: "SDL_SetHintWithPriority", Message: msg}
+		}
+		return &Error{Func: "SDL_SetHintWithPriority", Message: "hint " + name + " is overridden with a higher priority"}
+	}
+	return nil
+}
+
+func driverNames(num func() int32, get func(int32) string) []string {
+	names := make([]string, num())
+	for i := range names {
+		names[i] = get(int32(i))
+	}
+	return names
+}
+
+func containsFold(list []string, s string) bool {
+	for _, v := range list {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
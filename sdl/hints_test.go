@@ -0,0 +1,26 @@
+package sdl
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestLoadHintsWithoutLibrary(t *testing.T) {
+	loadErr := LoadLibrary()
+	if loadErr == nil {
+		t.Skip("the SDL library is available")
+	}
+
+	vsync := IntHint(HintRenderVSync, -1, 4)
+	err := LoadHints(strings.NewReader("SDL_VIDEO_DRIVER = x11\nSDL_RENDER_VSYNC = 5\n"), HintNormal, vsync)
+	var hintErr *HintError
+	if !errors.As(err, &hintErr) || hintErr.Name != HintRenderVSync {
+		t.Errorf("invalid value: got %v, want a *HintError for %s", err, HintRenderVSync)
+	}
+
+	err = LoadHints(strings.NewReader("SDL_VIDEO_DRIVER = x11\n"), HintNormal)
+	if err == nil || err.Error() != loadErr.Error() {
+		t.Errorf("valid value: got %v, want %v", err, loadErr)
+	}
+}
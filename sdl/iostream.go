@@ -0,0 +1,280 @@
+package sdl
+
+import (
+	"io"
+	"io/fs"
+	"runtime"
+	"strings"
+	"unsafe"
+)
+
+// An *IOStream can be passed to everything accepting the io interfaces, e.g. image/png.Decode:
+//
+//	stream := sdl.IOFromFile("image.png", "rb")
+//	defer stream.Close()
+//	img, err := png.Decode(stream)
+var (
+	_ io.ReadWriteSeeker = (*IOStream)(nil)
+	_ io.Closer          = (*IOStream)(nil)
+)
+
+// Read implements [io.Reader] using [ReadIO].
+func (context *IOStream) Read(p []byte) (int, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	if len(p) == 0 {
+		return 0, nil
+	}
+	n := ReadIO(context, unsafe.Pointer(&p[0]), uint64(len(p)))
+	if n > 0 {
+		return int(n), nil
+	}
+	return 0, ioError("SDL_ReadIO", GetIOStatus(context))
+}
+
+// Write implements [io.Writer] using [WriteIO].
+func (context *IOStream) Write(p []byte) (int, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	if len(p) == 0 {
+		return 0, nil
+	}
+	n := WriteIO(context, unsafe.Pointer(&p[0]), uint64(len(p)))
+	if int(n) < len(p) {
+		err := ioError("SDL_WriteIO", GetIOStatus(context))
+		if err == io.EOF {
+			// a fixed-size memory stream is full
+			err = io.ErrShortWrite
+		}
+		return int(n), err
+	}
+	return int(n), nil
+}
+
+// Seek implements [io.Seeker] using [SeekIO]. The whence values of package io match [IOWhence].
+func (context *IOStream) Seek(offset int64, whence int) (int64, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	pos := SeekIO(context, offset, IOWhence(whence))
+	if pos < 0 {
+		return 0, NewError("SDL_SeekIO")
+	}
+	return pos, nil
+}
+
+// Close implements [io.Closer] using [CloseIO]. The stream is freed, even if an error is returned,
+// and must not be used afterwards.
+func (context *IOStream) Close() error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return Check("SDL_CloseIO", CloseIO(context))
+}
+
+// Flush is like [FlushIO], but returns an error on failure.
+func (context *IOStream) Flush() error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return Check("SDL_FlushIO", FlushIO(context))
+}
+
+// Size is like [GetIOSize], but returns an error on failure.
+func (context *IOStream) Size() (int64, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	size := GetIOSize(context)
+	if size < 0 {
+		return 0, NewError("SDL_GetIOSize")
+	}
+	return size, nil
+}
+
+// ioError converts the status of a stream after a short read or write into an error.
+func ioError(fn string, status IOStatus) error {
+	switch status {
+	case IOStatusEof:
+		return io.EOF
+	case IOStatusNotReady:
+		return &Error{Func: fn, Message: "stream not ready"}
+	case IOStatusReadOnly:
+		return &Error{Func: fn, Message: "stream is read-only"}
+	case IOStatusWriteOnly:
+		return &Error{Func: fn, Message: "stream is write-only"}
+	default:
+		return NewError(fn)
+	}
+}
+
+// IOFromReader returns a read-only [IOStream] reading from r or nil on failure.
+//
+// The stream can be passed to every function taking an [IOStream], e.g. to load assets from an [embed.FS]
+// or a zip archive without copying them into memory first. Most loaders need to seek,
+// which is supported if r implements [io.Seeker]. The size of the stream is taken from a Size or Stat method
+// of r (like the ones of [bytes.Reader] and [fs.File]) or determined by seeking.
+//
+// Closing the stream closes r, if it implements [io.Closer]. The stream must be closed,
+// either by [CloseIO] or by passing closeio = true to a loader, to free its resources.
+func IOFromReader(r io.Reader) *IOStream {
+	return openGoIO(r, false)
+}
+
+// IOFromReadWriteSeeker is like [IOFromReader], but returns a stream supporting writes.
+// The stream is flushed by calling the Flush method of rws, if it has one.
+func IOFromReadWriteSeeker(rws io.ReadWriteSeeker) *IOStream {
+	return openGoIO(rws, true)
+}
+
+// goIO is the userdata of a stream created by [openGoIO].
+type goIO struct {
+	v        interface{}
+	writable bool
+}
+
+func openGoIO(v interface{}, writable bool) *IOStream {
+	h := newCallbackHandle(&goIO{v: v, writable: writable}, nil)
+	iface := goIOInterface()
+	stream := sdlOpenIO(&iface, h.pointer())
+	if stream == nil {
+		h.Release()
+	}
+	return stream
+}
+
+var goIOTrampolines struct {
+	size, seek, read, write, flush, close trampoline
+}
+
+// goIOInterface returns the interface shared by all streams created by [openGoIO].
+func goIOInterface() IOStreamInterface {
+	t := &goIOTrampolines
+	return IOStreamInterface{
+		Version: uint32(unsafe.Sizeof(IOStreamInterface{})),
+		Size: t.size.get(func(userdata uintptr) int64 {
+			s, _ := lookupCallback(userdata).(*goIO)
+			return s.size()
+		}),
+		Seek: t.seek.get(func(userdata uintptr, offset int64, whence IOWhence) int64 {
+			s, _ := lookupCallback(userdata).(*goIO)
+			return s.seek(offset, whence)
+		}),
+		Read: t.read.get(func(userdata uintptr, ptr unsafe.Pointer, size uint64, status *IOStatus) uint64 {
+			s, _ := lookupCallback(userdata).(*goIO)
+			return s.read(unsafe.Slice((*byte)(ptr), size), status)
+		}),
+		Write: t.write.get(func(userdata uintptr, ptr unsafe.Pointer, size uint64, status *IOStatus) uint64 {
+			s, _ := lookupCallback(userdata).(*goIO)
+			return s.write(unsafe.Slice((*byte)(ptr), size), status)
+		}),
+		Flush: t.flush.get(func(userdata uintptr, status *IOStatus) uintptr {
+			if s, _ := lookupCallback(userdata).(*goIO); s.flush(status) {
+				return 1
+			}
+			return 0
+		}),
+		Close: t.close.get(func(userdata uintptr) uintptr {
+			s, _ := lookupCallback(userdata).(*goIO)
+			releaseCallback(userdata)
+			if s.close() {
+				return 1
+			}
+			return 0
+		}),
+	}
+}
+
+func (s *goIO) size() int64 {
+	switch v := s.v.(type) {
+	case interface{ Size() int64 }:
+		return v.Size()
+	case interface{ Stat() (fs.FileInfo, error) }:
+		info, err := v.Stat()
+		if err != nil {
+			setGoError(err)
+			return -1
+		}
+		return info.Size()
+	case io.Seeker:
+		cur, err := v.Seek(0, io.SeekCurrent)
+		if err != nil {
+			setGoError(err)
+			return -1
+		}
+		end, err := v.Seek(0, io.SeekEnd)
+		if err != nil {
+			setGoError(err)
+			return -1
+		}
+		if _, err := v.Seek(cur, io.SeekStart); err != nil {
+			setGoError(err)
+			return -1
+		}
+		return end
+	}
+	SetError("stream size is unknown")
+	return -1
+}
+
+func (s *goIO) seek(offset int64, whence IOWhence) int64 {
+	seeker, ok := s.v.(io.Seeker)
+	if !ok {
+		SetError("stream is not seekable")
+		return -1
+	}
+	pos, err := seeker.Seek(offset, int(whence))
+	if err != nil {
+		setGoError(err)
+		return -1
+	}
+	return pos
+}
+
+// read fills p completely unless the reader fails, because SDL treats short reads as errors in many places.
+func (s *goIO) read(p []byte, status *IOStatus) uint64 {
+	n, err := io.ReadFull(s.v.(io.Reader), p)
+	switch {
+	case err == io.EOF || err == io.ErrUnexpectedEOF:
+		*status = IOStatusEof
+	case err != nil:
+		*status = IOStatusError
+		setGoError(err)
+	}
+	return uint64(n)
+}
+
+func (s *goIO) write(p []byte, status *IOStatus) uint64 {
+	if !s.writable {
+		*status = IOStatusReadOnly
+		return 0
+	}
+	n, err := s.v.(io.Writer).Write(p)
+	if err != nil {
+		*status = IOStatusError
+		setGoError(err)
+	}
+	return uint64(n)
+}
+
+func (s *goIO) flush(status *IOStatus) bool {
+	if f, ok := s.v.(interface{ Flush() error }); ok && s.writable {
+		if err := f.Flush(); err != nil {
+			*status = IOStatusError
+			setGoError(err)
+			return false
+		}
+	}
+	return true
+}
+
+func (s *goIO) close() bool {
+	if c, ok := s.v.(io.Closer); ok {
+		if err := c.Close(); err != nil {
+			setGoError(err)
+			return false
+		}
+	}
+	return true
+}
+
+// setGoError sets the message of err as the SDL error. [SetError] passes its result to SDL as a format string.
+func setGoError(err error) {
+	SetError("%s", strings.ReplaceAll(err.Error(), "%", "%%"))
+}
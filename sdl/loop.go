@@ -0,0 +1,183 @@
+package sdl
+
+import "time"
+
+// Loop runs a game loop with a fixed update rate and a variable render rate, timed with [GetPerformanceCounter]:
+//
+//	loop := sdl.Loop{
+//		UpdateInterval: time.Second / 60,
+//		FrameInterval:  time.Second / 144,
+//		Update: func(dt time.Duration) {
+//			world.Step(dt.Seconds())
+//		},
+//		Render: func(alpha float64) {
+//			world.Draw(renderer, alpha)
+//			sdl.RenderPresent(renderer)
+//		},
+//	}
+//	loop.Run()
+//
+// Each frame, Run polls the events, calls Update as often as needed to catch up with the elapsed time
+// and calls Render once. If the updates are slower than real time, at most MaxUpdates are run per frame
+// and the remaining time is dropped, so the game slows down instead of freezing.
+//
+// The loop pauses while the window is minimized or occluded. Events are still handled, but Update and Render
+// aren't called and the elapsed time isn't caught up on afterwards.
+type Loop struct {
+	UpdateInterval time.Duration // The fixed time step passed to Update. Defaults to 1/60 s.
+	FrameInterval  time.Duration // The minimum time between two calls of Render. If 0, the render rate isn't capped.
+	MaxUpdates     int           // The maximum number of updates per frame. Defaults to 5.
+	Window         *Window       // If set, only the events of this window pause the loop.
+
+	// HandleEvent is called for each event and returns false to stop the loop.
+	// If it is nil, the loop stops on [EventQuit].
+	HandleEvent func(event *Event) bool
+	// Update advances the game state by dt, which is always UpdateInterval.
+	Update func(dt time.Duration)
+	// Render draws a frame. The alpha in [0, 1) is the fraction of UpdateInterval that has passed since the last
+	// update, e.g. to interpolate between the previous and the current state.
+	Render func(alpha float64)
+
+	stats   LoopStats
+	stopped bool
+	hidden  map[WindowID]bool
+}
+
+// LoopStats holds the frame-time statistics of a [Loop].
+type LoopStats struct {
+	Frames           uint64        // The number of rendered frames.
+	Updates          uint64        // The number of updates.
+	DroppedUpdates   uint64        // The number of updates skipped to keep up with real time.
+	FrameTime        time.Duration // The time between the last two frames.
+	AverageFrameTime time.Duration // The exponential moving average of FrameTime.
+	MaxFrameTime     time.Duration // The longest FrameTime since the loop started.
+}
+
+// FPS returns the number of frames per second, based on the average frame time.
+func (s LoopStats) FPS() float64 {
+	if s.AverageFrameTime <= 0 {
+		return 0
+	}
+	return float64(time.Second) / float64(s.AverageFrameTime)
+}
+
+// Run runs the loop until HandleEvent returns false or [Loop.Stop] is called.
+func (l *Loop) Run() {
+	step := l.UpdateInterval
+	if step <= 0 {
+		step = time.Second / 60
+	}
+	maxUpdates := l.MaxUpdates
+	if maxUpdates <= 0 {
+		maxUpdates = 5
+	}
+	l.stats = LoopStats{}
+	l.stopped = false
+	l.hidden = make(map[WindowID]bool)
+
+	freq := GetPerformanceFrequency()
+	last := GetPerformanceCounter()
+	var lag time.Duration
+	for !l.stopped {
+		wasPaused := l.Paused()
+		var event Event
+		for PollEvent(&event) {
+			l.handle(&event)
+		}
+		if l.stopped {
+			break
+		}
+		if l.Paused() {
+			// block until something happens instead of spinning
+			if WaitEventTimeout(&event, 100) {
+				l.handle(&event)
+			}
+			continue
+		}
+		if wasPaused {
+			last, lag = GetPerformanceCounter(), 0
+		}
+
+		now := GetPerformanceCounter()
+		frame := counterDuration(now-last, freq)
+		last = now
+		l.record(frame)
+
+		lag += frame
+		for n := 0; lag >= step; n++ {
+			if n == maxUpdates {
+				l.stats.DroppedUpdates += uint64(lag / step)
+				lag %= step
+				break
+			}
+			if l.Update != nil {
+				l.Update(step)
+			}
+			l.stats.Updates++
+			lag -= step
+		}
+
+		if l.Render != nil {
+			l.Render(float64(lag) / float64(step))
+		}
+		l.stats.Frames++
+
+		if l.FrameInterval > 0 {
+			if elapsed := counterDuration(GetPerformanceCounter()-now, freq); elapsed < l.FrameInterval {
+				DelayNS(uint64(l.FrameInterval - elapsed))
+			}
+		}
+	}
+}
+
+// Stop stops the loop after the current frame. It must be called from the goroutine running the loop,
+// e.g. from one of the callbacks.
+func (l *Loop) Stop() {
+	l.stopped = true
+}
+
+// Paused reports whether the loop is paused, because the window is minimized or occluded.
+func (l *Loop) Paused() bool {
+	return len(l.hidden) > 0
+}
+
+// Stats returns the frame-time statistics.
+func (l *Loop) Stats() LoopStats {
+	return l.stats
+}
+
+func (l *Loop) handle(event *Event) {
+	switch event.Type() {
+	case EventWindowMinimized, EventWindowOccluded, EventWindowHidden:
+		if id := event.Window().WindowID; l.Window == nil || id == GetWindowID(l.Window) {
+			l.hidden[id] = true
+		}
+	case EventWindowRestored, EventWindowMaximized, EventWindowExposed, EventWindowShown, EventWindowDestroyed:
+		delete(l.hidden, event.Window().WindowID)
+	}
+
+	if l.HandleEvent != nil {
+		if !l.HandleEvent(event) {
+			l.stopped = true
+		}
+	} else if event.Type() == EventQuit {
+		l.stopped = true
+	}
+}
+
+func (l *Loop) record(frame time.Duration) {
+	l.stats.FrameTime = frame
+	if l.stats.AverageFrameTime == 0 {
+		l.stats.AverageFrameTime = frame
+	} else {
+		l.stats.AverageFrameTime += (frame - l.stats.AverageFrameTime) / 10
+	}
+	if frame > l.stats.MaxFrameTime {
+		l.stats.MaxFrameTime = frame
+	}
+}
+
+// counterDuration converts a difference of [GetPerformanceCounter] values into a duration without overflowing.
+func counterDuration(counter, freq uint64) time.Duration {
+	return time.Duration(counter/freq)*time.Second + time.Duration(counter%freq*uint64(time.Second)/freq)
+}
@@ -0,0 +1,144 @@
+package sdl
+
+import (
+	"bytes"
+	"runtime"
+	"strconv"
+	"sync"
+)
+
+// mainQueue holds the functions queued by [Do] and [DoAsync]. It is drained by the loop in [Main] and,
+// while the main thread is busy, by SDL's event processing through [RunOnMainThread].
+//
+// The main goroutine is the one that ran the package initialization, which locked it to the main thread,
+// until [Main] is called by another one.
+var mainQueue = struct {
+	sync.Mutex
+	funcs     []func()
+	wake      chan struct{}
+	goroutine uint64
+}{wake: make(chan struct{}, 1), goroutine: goroutineID()}
+
+var mainThreadTrampoline trampoline
+
+func mainThreadCallbackFunc() MainThreadCallback {
+	return MainThreadCallback(mainThreadTrampoline.get(func(userdata uintptr) uintptr {
+		// SDL runs the callback right away if it is called on its main thread, which isn't necessarily the one of Main.
+		if onMainThread() {
+			runMainQueue()
+		}
+		return 0
+	}))
+}
+
+// Main runs the main loop on the main thread and calls f in a new goroutine. It returns when f returns.
+//
+// Call it from main.main. The package locks the main goroutine to the main thread during initialization,
+// so Main is where functions queued by [Do] and [DoAsync] are executed. Video, render and event functions
+// should only be called through them:
+//
+//	func main() {
+//		sdl.Main(func() {
+//			sdl.Do(func() {
+//				sdl.Init(sdl.InitVideo)
+//			})
+//			...
+//		})
+//	}
+func Main(f func()) {
+	mainQueue.Lock()
+	mainQueue.goroutine = goroutineID()
+	mainQueue.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		f()
+	}()
+
+	for {
+		select {
+		case <-mainQueue.wake:
+			runMainQueue()
+		case <-done:
+			runMainQueue()
+			return
+		}
+	}
+}
+
+// Do runs fn on the main thread and waits for it to complete. It can be called from any goroutine.
+//
+// If the main thread is blocked in [WaitEvent] or busy processing events, fn runs from within SDL's event processing.
+// Calling Do on the main goroutine itself, e.g. from a function queued by Do or from main.main before [Main],
+// runs fn immediately.
+func Do(fn func()) {
+	if onMainThread() {
+		fn()
+		return
+	}
+
+	done := make(chan struct{})
+	DoAsync(func() {
+		defer close(done)
+		fn()
+	})
+	<-done
+}
+
+// DoAsync queues fn to run on the main thread and returns immediately. Functions run in the order they were queued.
+func DoAsync(fn func()) {
+	mainQueue.Lock()
+	mainQueue.funcs = append(mainQueue.funcs, fn)
+	mainQueue.Unlock()
+
+	select {
+	case mainQueue.wake <- struct{}{}:
+	default:
+	}
+
+	// This also wakes up a blocked WaitEvent. Before the event subsystem is initialized, SDL would run the callback
+	// right away on the calling thread and make it SDL's main thread.
+	if sdlRunOnMainThread != nil && !onMainThread() && WasInit(InitEvents) != 0 {
+		sdlRunOnMainThread(mainThreadCallbackFunc(), nil, false)
+	}
+}
+
+// onMainThread reports whether the caller is the main goroutine, which is locked to the main thread.
+// Callbacks from SDL on the main thread run on the goroutine that called into SDL, so they are detected as well.
+func onMainThread() bool {
+	mainQueue.Lock()
+	goroutine := mainQueue.goroutine
+	mainQueue.Unlock()
+	return goroutine == goroutineID()
+}
+
+// goroutineID returns the ID of the calling goroutine, taken from the header of its stack trace,
+// e.g. "goroutine 1 [running]:".
+func goroutineID() uint64 {
+	var buf [64]byte
+	b := bytes.TrimPrefix(buf[:runtime.Stack(buf[:], false)], []byte("goroutine "))
+	if i := bytes.IndexByte(b, ' '); i >= 0 {
+		b = b[:i]
+	}
+	id, _ := strconv.ParseUint(string(b), 10, 64)
+	return id
+}
+
+// runMainQueue runs all queued functions one by one, so that nested calls from within SDL's event processing
+// keep the order. It must only be called on the main thread.
+func runMainQueue() {
+	for {
+		mainQueue.Lock()
+		if len(mainQueue.funcs) == 0 {
+			mainQueue.Unlock()
+			return
+		}
+		fn := mainQueue.funcs[0]
+		mainQueue.funcs[0] = nil
+		mainQueue.funcs = mainQueue.funcs[1:]
+		mainQueue.Unlock()
+
+		fn()
+	}
+}
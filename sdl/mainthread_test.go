@@ -0,0 +1,87 @@
+package sdl
+
+import (
+	"runtime"
+	"testing"
+	"time"
+)
+
+// asMainGoroutine locks the calling goroutine to its thread and makes it the main goroutine, like the package
+// initialization does. The returned function undoes that and must be called by the same goroutine.
+func asMainGoroutine() (restore func()) {
+	runtime.LockOSThread()
+	mainQueue.Lock()
+	saved := mainQueue.goroutine
+	mainQueue.goroutine = goroutineID()
+	mainQueue.Unlock()
+	return func() {
+		mainQueue.Lock()
+		mainQueue.goroutine = saved
+		mainQueue.Unlock()
+		runtime.UnlockOSThread()
+	}
+}
+
+// finishes fails the test if f doesn't return within a second, instead of hanging.
+func finishes(t *testing.T, f func()) {
+	t.Helper()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		f()
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("deadlock")
+	}
+}
+
+func TestDoBeforeInit(t *testing.T) {
+	if err := LoadLibrary(); err != nil {
+		t.Skip(err)
+	}
+	if WasInit(InitEvents) != 0 {
+		t.Skip("the event subsystem is already initialized")
+	}
+	defer asMainGoroutine()()
+
+	mainThread := GetCurrentThreadID()
+	var thread ThreadID
+	Main(func() {
+		Do(func() {
+			thread = GetCurrentThreadID()
+		})
+	})
+	if thread != mainThread {
+		t.Fatalf("Do ran on thread %d, want the thread of Main %d", thread, mainThread)
+	}
+}
+
+func TestDoOnMainGoroutine(t *testing.T) {
+	t.Run("before Main", func(t *testing.T) {
+		finishes(t, func() {
+			defer asMainGoroutine()()
+			ran := false
+			Do(func() { ran = true })
+			if !ran {
+				t.Error("fn didn't run")
+			}
+		})
+	})
+
+	t.Run("nested", func(t *testing.T) {
+		finishes(t, func() {
+			defer asMainGoroutine()()
+			ran := false
+			Main(func() {
+				Do(func() {
+					Do(func() { ran = true })
+				})
+			})
+			if !ran {
+				t.Error("fn didn't run")
+			}
+		})
+	})
+}
@@ -0,0 +1,192 @@
+package sdl
+
+import (
+	"math"
+	"runtime"
+	"sync"
+	"time"
+)
+
+// A *Mutex or *RWLock can be used wherever a [sync.Locker] is expected, e.g. to share data with audio callbacks
+// running on SDL's threads, which have to lock the same mutex from C.
+//
+// SDL mutexes belong to the thread that locked them, but goroutines move between threads. So the methods lock
+// the goroutine to its current thread with [runtime.LockOSThread] until the mutex is unlocked. The functions
+// like [LockMutex] don't, so they may only be used on threads that are locked already.
+var (
+	_ sync.Locker = (*Mutex)(nil)
+	_ sync.Locker = (*RWLock)(nil)
+)
+
+// Lock locks the mutex with [LockMutex]. Mutexes are recursive, so a goroutine may lock it multiple times,
+// but must unlock it as often.
+func (mutex *Mutex) Lock() {
+	runtime.LockOSThread()
+	LockMutex(mutex)
+}
+
+// TryLock tries to lock the mutex with [TryLockMutex] and reports whether it succeeded.
+func (mutex *Mutex) TryLock() bool {
+	runtime.LockOSThread()
+	if !TryLockMutex(mutex) {
+		runtime.UnlockOSThread()
+		return false
+	}
+	return true
+}
+
+// Unlock unlocks the mutex with [UnlockMutex]. It must be called by the goroutine that locked the mutex.
+func (mutex *Mutex) Unlock() {
+	UnlockMutex(mutex)
+	runtime.UnlockOSThread()
+}
+
+// Lock locks rw for writing with [LockRWLockForWriting].
+func (rw *RWLock) Lock() {
+	runtime.LockOSThread()
+	LockRWLockForWriting(rw)
+}
+
+// TryLock tries to lock rw for writing with [TryLockRWLockForWriting] and reports whether it succeeded.
+func (rw *RWLock) TryLock() bool {
+	runtime.LockOSThread()
+	if !TryLockRWLockForWriting(rw) {
+		runtime.UnlockOSThread()
+		return false
+	}
+	return true
+}
+
+// RLock locks rw for reading with [LockRWLockForReading].
+func (rw *RWLock) RLock() {
+	runtime.LockOSThread()
+	LockRWLockForReading(rw)
+}
+
+// TryRLock tries to lock rw for reading with [TryLockRWLockForReading] and reports whether it succeeded.
+func (rw *RWLock) TryRLock() bool {
+	runtime.LockOSThread()
+	if !TryLockRWLockForReading(rw) {
+		runtime.UnlockOSThread()
+		return false
+	}
+	return true
+}
+
+// Unlock unlocks rw with [UnlockRWLock]. It must be called by the goroutine that locked rw for writing.
+func (rw *RWLock) Unlock() {
+	UnlockRWLock(rw)
+	runtime.UnlockOSThread()
+}
+
+// RUnlock unlocks rw with [UnlockRWLock]. It must be called by the goroutine that locked rw for reading.
+func (rw *RWLock) RUnlock() {
+	UnlockRWLock(rw)
+	runtime.UnlockOSThread()
+}
+
+// RLocker returns a [sync.Locker] that calls [RWLock.RLock] and [RWLock.RUnlock].
+func (rw *RWLock) RLocker() sync.Locker {
+	return rlocker{rw}
+}
+
+type rlocker struct {
+	rw *RWLock
+}
+
+func (r rlocker) Lock()   { r.rw.RLock() }
+func (r rlocker) Unlock() { r.rw.RUnlock() }
+
+// Wait waits with [WaitSemaphore] until the value of sem is positive and decrements it.
+func (sem *Semaphore) Wait() {
+	WaitSemaphore(sem)
+}
+
+// TryWait decrements the value of sem if it is positive with [TryWaitSemaphore] and reports whether it did.
+func (sem *Semaphore) TryWait() bool {
+	return TryWaitSemaphore(sem)
+}
+
+// WaitTimeout is like [Semaphore.Wait], but gives up after timeout and reports whether the value was decremented.
+// A negative timeout waits indefinitely.
+func (sem *Semaphore) WaitTimeout(timeout time.Duration) bool {
+	return WaitSemaphoreTimeout(sem, timeoutMS(timeout))
+}
+
+// Signal increments the value of sem with [SignalSemaphore].
+func (sem *Semaphore) Signal() {
+	SignalSemaphore(sem)
+}
+
+// Value returns the current value of sem with [GetSemaphoreValue].
+func (sem *Semaphore) Value() uint32 {
+	return GetSemaphoreValue(sem)
+}
+
+// Wait unlocks mutex, waits with [WaitCondition] until cond is signaled and locks mutex again.
+// The mutex must be locked by the calling goroutine, e.g. with [Mutex.Lock].
+func (cond *Condition) Wait(mutex *Mutex) {
+	WaitCondition(cond, mutex)
+}
+
+// WaitTimeout is like [Condition.Wait], but gives up after timeout and reports whether cond was signaled.
+// A negative timeout waits indefinitely. The mutex is locked again in both cases.
+func (cond *Condition) WaitTimeout(mutex *Mutex, timeout time.Duration) bool {
+	return WaitConditionTimeout(cond, mutex, timeoutMS(timeout))
+}
+
+// Signal wakes up one waiting goroutine or thread with [SignalCondition].
+func (cond *Condition) Signal() {
+	SignalCondition(cond)
+}
+
+// Broadcast wakes up all waiting goroutines and threads with [BroadcastCondition].
+func (cond *Condition) Broadcast() {
+	BroadcastCondition(cond)
+}
+
+// Init runs init once with [ShouldInit] and [SetInitialized], unless the state is initialized already,
+// and reports whether it is initialized afterwards. If init returns false, a later call tries again.
+// Concurrent calls wait until the first one is done. SDL expects [SetInitialized] on the thread that called
+// [ShouldInit], so the goroutine is locked to its thread while init runs. The state must not be copied and must live in Go memory
+// that isn't freed while SDL uses it, e.g. a global variable:
+//
+//	var audioState sdl.InitState
+//
+//	func initAudio() bool {
+//		return audioState.Init(func() bool {
+//			return sdl.InitSubSystem(sdl.InitAudio)
+//		})
+//	}
+func (state *InitState) Init(init func() bool) bool {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	if !ShouldInit(state) {
+		return state.Status.Get() == int32(InitStatusInitialized)
+	}
+	ok := init()
+	SetInitialized(state, ok)
+	return ok
+}
+
+// Quit runs quit with [ShouldQuit] and [SetInitialized], if the state is initialized, and resets it,
+// so that [InitState.Init] initializes again. Like Init, it locks the goroutine to its thread while quit runs.
+func (state *InitState) Quit(quit func()) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	if ShouldQuit(state) {
+		quit()
+		SetInitialized(state, false)
+	}
+}
+
+// timeoutMS converts a timeout for SDL functions taking milliseconds. Negative timeouts mean no timeout.
+func timeoutMS(timeout time.Duration) int32 {
+	switch {
+	case timeout < 0:
+		return -1
+	case timeout/time.Millisecond > math.MaxInt32:
+		return math.MaxInt32
+	}
+	return int32(timeout / time.Millisecond)
+}
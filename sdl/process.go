@@ -0,0 +1,361 @@
+package sdl
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"unsafe"
+
+	"github.com/jupiterrider/purego-sdl3/internal/convert"
+)
+
+// Cmd is a child process prepared with [Command] and run with [CreateProcessWithProperties], similar to an exec.Cmd:
+//
+//	cmd := sdl.Command("glslc", "-fshader-stage=frag", "-o", "-", "shader.glsl")
+//	cmd.Dir = "assets/shaders"
+//	spirv, err := cmd.Output()
+//
+// A Cmd can't be reused after [Cmd.Wait], [Cmd.Run], [Cmd.Output] or [Cmd.CombinedOutput].
+type Cmd struct {
+	Args []string // The program, which is looked up in PATH, followed by its arguments.
+	Env  []string // The environment as "key=value" pairs. If nil, the environment of the application is inherited.
+	Dir  string   // The working directory. If empty, it is the one of the application. Requires SDL 3.4.0.
+
+	// Where the standard I/O streams are directed. The zero value inherits them from the application. Unlike with
+	// [CreateProcess] and exec.Cmd, this includes standard input, set Stdin to [ProcessStdioNull] to ignore it.
+	// With [ProcessStdioApp], they are available through [Cmd.StdinPipe], [Cmd.StdoutPipe] and [Cmd.StderrPipe]
+	// after [Cmd.Start].
+	Stdin, Stdout, Stderr ProcessIO
+	// The streams used with [ProcessStdioRedirect]. They must be backed by a file descriptor, e.g. from [IOFromFile].
+	StdinSource, StdoutSource, StderrSource *IOStream
+	// StderrToStdout directs the standard error stream to the standard output stream. Stderr is ignored.
+	StderrToStdout bool
+	// Background runs the process in the background. On Windows, this avoids creating a console window.
+	Background bool
+
+	process  *Process
+	finished bool
+	files    []*os.File // the pipes opened by pipeFile
+}
+
+// ExitError is returned by [Cmd.Wait] and its relatives if the process exited with a code other than 0.
+type ExitError struct {
+	Code int32 // The exit code. On POSIX platforms, processes terminated by a signal report the negated signal number.
+}
+
+func (e *ExitError) Error() string {
+	return "sdl: exit status " + strconv.Itoa(int(e.Code))
+}
+
+// Command returns a [Cmd] running the program name with the given arguments.
+func Command(name string, arg ...string) *Cmd {
+	return &Cmd{Args: append([]string{name}, arg...)}
+}
+
+// Start starts the process without waiting for it. [Cmd.Wait] must be called afterwards to free its resources.
+func (c *Cmd) Start() error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	if c.process != nil {
+		return errors.New("sdl: process already started")
+	}
+	if len(c.Args) == 0 {
+		return errors.New("sdl: no command")
+	}
+
+	props := CreateProperties()
+	if props == 0 {
+		return NewError("SDL_CreateProperties")
+	}
+	defer DestroyProperties(props)
+
+	args := make([]*byte, len(c.Args)+1)
+	for i, arg := range c.Args {
+		args[i] = convert.ToBytePtr(arg)
+	}
+	SetPointerProperty(props, PropProcessCreateArgsPointer, unsafe.Pointer(&args[0]))
+
+	if c.Env != nil {
+		env := CreateEnvironment(false)
+		if env == nil {
+			return NewError("SDL_CreateEnvironment")
+		}
+		defer DestroyEnvironment(env)
+		for _, pair := range c.Env {
+			key, value, _ := strings.Cut(pair, "=")
+			SetEnvironmentVariable(env, key, value, true)
+		}
+		SetPointerProperty(props, PropProcessCreateEnvironmentPointer, unsafe.Pointer(env))
+	}
+	if c.Dir != "" {
+		SetStringProperty(props, PropProcessCreateWorkingDirectoryString, c.Dir)
+	}
+
+	stdio := []struct {
+		option         ProcessIO
+		source         *IOStream
+		number, stream string
+	}{
+		{c.Stdin, c.StdinSource, PropProcessCreateStdinNumber, PropProcessCreateStdinPointer},
+		{c.Stdout, c.StdoutSource, PropProcessCreateStdoutNumber, PropProcessCreateStdoutPointer},
+		{c.Stderr, c.StderrSource, PropProcessCreateStderrNumber, PropProcessCreateStderrPointer},
+	}
+	for _, s := range stdio {
+		SetNumberProperty(props, s.number, int64(s.option))
+		if s.option == ProcessStdioRedirect {
+			SetPointerProperty(props, s.stream, unsafe.Pointer(s.source))
+		}
+	}
+	SetBooleanProperty(props, PropProcessCreateStderrToStdoutBoolean, c.StderrToStdout)
+	SetBooleanProperty(props, PropProcessCreateBackgroundBoolean, c.Background)
+
+	c.process = CreateProcessWithProperties(props)
+	runtime.KeepAlive(args)
+	if c.process == nil {
+		return NewError("SDL_CreateProcessWithProperties")
+	}
+	return nil
+}
+
+// Wait waits for the process to exit with [WaitProcess] and frees its resources. The pipes must not be used
+// anymore, so all reads from them must be completed first. A process that exited with a code other than 0
+// is reported as [*ExitError].
+func (c *Cmd) Wait() error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	if err := c.started(); err != nil {
+		return err
+	}
+	var exitcode int32
+	ok := WaitProcess(c.process, true, &exitcode)
+	var err error
+	if !ok {
+		err = NewError("SDL_WaitProcess")
+	}
+	c.destroy()
+	if err != nil {
+		return err
+	}
+	return exitError(exitcode)
+}
+
+// Run starts the process and waits for it to exit.
+func (c *Cmd) Run() error {
+	if err := c.Start(); err != nil {
+		return err
+	}
+	return c.Wait()
+}
+
+// Output runs the process and returns its standard output, read with [ReadProcess]. It sets Stdout to [ProcessStdioApp].
+// The output is returned together with an [*ExitError].
+func (c *Cmd) Output() ([]byte, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	c.Stdout = ProcessStdioApp
+	if err := c.Start(); err != nil {
+		return nil, err
+	}
+
+	var size uint64
+	var exitcode int32
+	data := ReadProcess(c.process, &size, &exitcode)
+	if data == nil {
+		err := NewError("SDL_ReadProcess")
+		c.destroy()
+		return nil, err
+	}
+	out := make([]byte, size)
+	copy(out, unsafe.Slice((*byte)(data), size))
+	Free(data)
+	c.destroy()
+	return out, exitError(exitcode)
+}
+
+// CombinedOutput is like [Cmd.Output], but returns the standard error stream together with the standard output.
+func (c *Cmd) CombinedOutput() ([]byte, error) {
+	c.StderrToStdout = true
+	return c.Output()
+}
+
+// StdinPipe returns the standard input of a started process, which requires Stdin to be [ProcessStdioApp].
+// Closing it signals the end of the input to the process.
+func (c *Cmd) StdinPipe() (io.WriteCloser, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	if err := c.started(); err != nil {
+		return nil, err
+	}
+	stream := GetProcessInput(c.process)
+	if stream == nil {
+		return nil, NewError("SDL_GetProcessInput")
+	}
+	return &processInput{stream: stream, file: c.pipeFile(stream), process: c.process}, nil
+}
+
+// StdoutPipe returns the standard output of a started process, which requires Stdout to be [ProcessStdioApp].
+// Reads wait until output is available or the process closes it. If the output isn't read, the process may block
+// writing it.
+func (c *Cmd) StdoutPipe() (io.Reader, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	if err := c.started(); err != nil {
+		return nil, err
+	}
+	stream := GetProcessOutput(c.process)
+	if stream == nil {
+		return nil, NewError("SDL_GetProcessOutput")
+	}
+	return processOutput{stream: stream, file: c.pipeFile(stream)}, nil
+}
+
+// StderrPipe returns the standard error of a started process, which requires Stderr to be [ProcessStdioApp].
+// Reads wait like the ones of [Cmd.StdoutPipe].
+func (c *Cmd) StderrPipe() (io.Reader, error) {
+	if err := c.started(); err != nil {
+		return nil, err
+	}
+	stream := (*IOStream)(GetPointerProperty(GetProcessProperties(c.process), PropProcessStderrPointer, nil))
+	if stream == nil {
+		return nil, errors.New("sdl: standard error is not piped")
+	}
+	return processOutput{stream: stream, file: c.pipeFile(stream)}, nil
+}
+
+// Kill stops a started process with [KillProcess]. With force, it is terminated immediately (SIGKILL on POSIX),
+// otherwise it is asked to quit (SIGTERM). [Cmd.Wait] must still be called.
+func (c *Cmd) Kill(force bool) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	if err := c.started(); err != nil {
+		return err
+	}
+	return Check("SDL_KillProcess", KillProcess(c.process, force))
+}
+
+// Pid returns the process ID of a started process or 0 if it isn't running.
+func (c *Cmd) Pid() int64 {
+	if c.process == nil || c.finished {
+		return 0
+	}
+	return GetNumberProperty(GetProcessProperties(c.process), PropProcessPidNumber, 0)
+}
+
+func (c *Cmd) started() error {
+	switch {
+	case c.process == nil:
+		return errors.New("sdl: process not started")
+	case c.finished:
+		return errors.New("sdl: process already finished")
+	}
+	return nil
+}
+
+func (c *Cmd) destroy() {
+	for _, f := range c.files {
+		f.Close()
+	}
+	DestroyProcess(c.process)
+	c.finished = true
+}
+
+// pipeFile returns an [*os.File] for a pipe of the process, which is closed together with the process, or nil.
+func (c *Cmd) pipeFile(stream *IOStream) *os.File {
+	f := pipeFile(stream)
+	if f != nil {
+		c.files = append(c.files, f)
+	}
+	return f
+}
+
+func exitError(exitcode int32) error {
+	if exitcode != 0 {
+		return &ExitError{Code: exitcode}
+	}
+	return nil
+}
+
+// pipeRetryDelay is how long the pipes of a process wait before retrying a read or write that wasn't ready.
+const pipeRetryDelay = 1000000 // 1ms
+
+// processOutput reads from a pipe of a process. SDL makes the pipes non-blocking and only offers a blocking read
+// of the whole output with [ReadProcess]. So if there is a file, a duplicate of the pipe's file descriptor
+// created by pipeFile, the reads go through it and Go's runtime poller waits for data without using the CPU.
+// Otherwise SDL offers no way to wait, so reads without data available are retried after a short delay,
+// until there is some, the end of the output is reached or an error occurs.
+type processOutput struct {
+	stream *IOStream
+	file   *os.File
+}
+
+func (out processOutput) Read(p []byte) (int, error) {
+	if out.file != nil {
+		return out.file.Read(p)
+	}
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	if len(p) == 0 {
+		return 0, nil
+	}
+	for {
+		n := ReadIO(out.stream, unsafe.Pointer(&p[0]), uint64(len(p)))
+		if n > 0 {
+			return int(n), nil
+		}
+		if status := GetIOStatus(out.stream); status != IOStatusNotReady {
+			return 0, ioError("SDL_ReadIO", status)
+		}
+		DelayNS(pipeRetryDelay)
+	}
+}
+
+// processInput closes the standard input of a process without closing it again in [DestroyProcess].
+// Like [processOutput], it writes through the file if there is one and otherwise retries writes to the
+// non-blocking pipe, until all of p is written or an error occurs.
+type processInput struct {
+	stream  *IOStream
+	file    *os.File
+	process *Process
+}
+
+func (in *processInput) Write(p []byte) (int, error) {
+	if in.stream == nil {
+		return 0, fs.ErrClosed
+	}
+	if in.file != nil {
+		return in.file.Write(p)
+	}
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	written := 0
+	for written < len(p) {
+		n := WriteIO(in.stream, unsafe.Pointer(&p[written]), uint64(len(p)-written))
+		written += int(n)
+		if n > 0 {
+			continue
+		}
+		if status := GetIOStatus(in.stream); status != IOStatusNotReady {
+			return written, ioError("SDL_WriteIO", status)
+		}
+		DelayNS(pipeRetryDelay)
+	}
+	return written, nil
+}
+
+func (in *processInput) Close() error {
+	if in.stream == nil {
+		return fs.ErrClosed
+	}
+	if in.file != nil {
+		// the duplicate would keep the pipe open
+		in.file.Close()
+	}
+	err := in.stream.Close()
+	in.stream = nil
+	SetPointerProperty(GetProcessProperties(in.process), PropProcessStdinPointer, nil)
+	return err
+}
@@ -0,0 +1,27 @@
+//go:build !windows
+
+package sdl
+
+import (
+	"os"
+	"syscall"
+)
+
+// pipeFile returns a duplicate of the file descriptor behind stream, or nil if it has none. The descriptor is
+// non-blocking, so [os.NewFile] registers it with Go's runtime poller, which makes reads and writes wait.
+func pipeFile(stream *IOStream) *os.File {
+	fd := GetNumberProperty(GetIOProperties(stream), PropIOStreamFileDescriptorNumber, -1)
+	if fd < 0 {
+		return nil
+	}
+	syscall.ForkLock.RLock()
+	dup, err := syscall.Dup(int(fd))
+	if err == nil {
+		syscall.CloseOnExec(dup)
+	}
+	syscall.ForkLock.RUnlock()
+	if err != nil {
+		return nil
+	}
+	return os.NewFile(uintptr(dup), "sdl process pipe")
+}
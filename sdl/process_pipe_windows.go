@@ -0,0 +1,8 @@
+package sdl
+
+import "os"
+
+// pipeFile returns nil. SDL makes the pipes non-blocking on Windows too, which Go's runtime poller can't wait on.
+func pipeFile(stream *IOStream) *os.File {
+	return nil
+}
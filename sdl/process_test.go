@@ -0,0 +1,79 @@
+package sdl
+
+import (
+	"io"
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+func TestStdoutPipeWaitsForOutput(t *testing.T) {
+	if err := LoadLibrary(); err != nil {
+		t.Skip(err)
+	}
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip(err)
+	}
+
+	cmd := Command("sh", "-c", "sleep 0.1; echo hi")
+	cmd.Stdout = ProcessStdioApp
+	if err := cmd.Start(); err != nil {
+		t.Fatal(err)
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := io.ReadAll(stdout)
+	if err != nil {
+		t.Error(err)
+	}
+	if err := cmd.Wait(); err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != "hi\n" {
+		t.Errorf("got %q, want %q", out, "hi\n")
+	}
+}
+
+func TestStdinPipeWritesEverything(t *testing.T) {
+	if err := LoadLibrary(); err != nil {
+		t.Skip(err)
+	}
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip(err)
+	}
+
+	// more than a pipe buffer, read only after a delay
+	cmd := Command("sh", "-c", "sleep 0.1; wc -c")
+	cmd.Stdin = ProcessStdioApp
+	cmd.Stdout = ProcessStdioApp
+	if err := cmd.Start(); err != nil {
+		t.Fatal(err)
+	}
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := make([]byte, 1<<20)
+	if n, err := stdin.Write(data); err != nil || n != len(data) {
+		t.Errorf("Write: got %d, %v, want %d", n, err, len(data))
+	}
+	if err := stdin.Close(); err != nil {
+		t.Error(err)
+	}
+	out, err := io.ReadAll(stdout)
+	if err != nil {
+		t.Error(err)
+	}
+	if err := cmd.Wait(); err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.TrimSpace(string(out)); got != "1048576" {
+		t.Errorf("got %q, want %q", got, "1048576")
+	}
+}
@@ -1,5 +1,17 @@
 package sdl
 
+import "unsafe"
+
+// [AsyncIO] is the asynchronous I/O operation structure.
+//
+// [AsyncIO]: https://wiki.libsdl.org/SDL3/SDL_AsyncIO
+type AsyncIO struct{}
+
+// [AsyncIOQueue] is a queue of completed asynchronous I/O tasks.
+//
+// [AsyncIOQueue]: https://wiki.libsdl.org/SDL3/SDL_AsyncIOQueue
+type AsyncIOQueue struct{}
+
 // [AsyncIOTaskType] defines the types of asynchronous I/O tasks.
 //
 // [AsyncIOTaskType]: https://wiki.libsdl.org/SDL3/SDL_AsyncIOTaskType
@@ -22,46 +34,93 @@ const (
 	AsyncIOCanceled                      // Request was canceled before completing.
 )
 
-// func AsyncIOFromFile(file string, mode string) *AsyncIO {
-//	return sdlAsyncIOFromFile(file, mode)
-// }
+// [AsyncIOFromFile] creates an [AsyncIO] to read and write a file asynchronously or returns nil on failure.
+//
+// [AsyncIOFromFile]: https://wiki.libsdl.org/SDL3/SDL_AsyncIOFromFile
+func AsyncIOFromFile(file string, mode string) *AsyncIO {
+	return sdlAsyncIOFromFile(file, mode)
+}
+
+// [CloseAsyncIO] closes an [AsyncIO] asynchronously. The result arrives in the queue like every other task.
+//
+// [CloseAsyncIO]: https://wiki.libsdl.org/SDL3/SDL_CloseAsyncIO
+func CloseAsyncIO(asyncio *AsyncIO, flush bool, queue *AsyncIOQueue, userdata unsafe.Pointer) bool {
+	return sdlCloseAsyncIO(asyncio, flush, queue, userdata)
+}
 
-// func CloseAsyncIO(asyncio *AsyncIO, flush bool, queue *AsyncIOQueue, userdata unsafe.Pointer) bool {
-//	return sdlCloseAsyncIO(asyncio, flush, queue, userdata)
-// }
+// [CreateAsyncIOQueue] creates a task queue for tracking multiple I/O operations or returns nil on failure.
+//
+// [CreateAsyncIOQueue]: https://wiki.libsdl.org/SDL3/SDL_CreateAsyncIOQueue
+func CreateAsyncIOQueue() *AsyncIOQueue {
+	return sdlCreateAsyncIOQueue()
+}
 
-// func CreateAsyncIOQueue() *AsyncIOQueue {
-//	return sdlCreateAsyncIOQueue()
-// }
+// [DestroyAsyncIOQueue] destroys a task queue. It blocks until all pending tasks are complete.
+//
+// [DestroyAsyncIOQueue]: https://wiki.libsdl.org/SDL3/SDL_DestroyAsyncIOQueue
+func DestroyAsyncIOQueue(queue *AsyncIOQueue) {
+	sdlDestroyAsyncIOQueue(queue)
+}
 
-// func DestroyAsyncIOQueue(queue *AsyncIOQueue)  {
-//	sdlDestroyAsyncIOQueue(queue)
-// }
+// [GetAsyncIOResult] queries an async I/O task queue for completed tasks without blocking.
+//
+// [GetAsyncIOResult]: https://wiki.libsdl.org/SDL3/SDL_GetAsyncIOResult
+func GetAsyncIOResult(queue *AsyncIOQueue, outcome *AsyncIOOutcome) bool {
+	return sdlGetAsyncIOResult(queue, outcome)
+}
 
-// func GetAsyncIOResult(queue *AsyncIOQueue, outcome *AsyncIOOutcome) bool {
-//	return sdlGetAsyncIOResult(queue, outcome)
-// }
+// [GetAsyncIOSize] returns the size of the data stream of an [AsyncIO] or a negative error code.
+//
+// [GetAsyncIOSize]: https://wiki.libsdl.org/SDL3/SDL_GetAsyncIOSize
+func GetAsyncIOSize(asyncio *AsyncIO) int64 {
+	return sdlGetAsyncIOSize(asyncio)
+}
 
-// func GetAsyncIOSize(asyncio *AsyncIO) int64 {
-//	return sdlGetAsyncIOSize(asyncio)
-// }
+// [LoadFileAsync] loads all the data from a file path asynchronously. The buffer of the outcome must be freed with [Free].
+//
+// [LoadFileAsync]: https://wiki.libsdl.org/SDL3/SDL_LoadFileAsync
+func LoadFileAsync(file string, queue *AsyncIOQueue, userdata unsafe.Pointer) bool {
+	return sdlLoadFileAsync(file, queue, userdata)
+}
 
-// func LoadFileAsync(file string, queue *AsyncIOQueue, userdata unsafe.Pointer) bool {
-//	return sdlLoadFileAsync(file, queue, userdata)
-// }
+// [ReadAsyncIO] starts an async read. The buffer must stay valid until the task is complete.
+//
+// [ReadAsyncIO]: https://wiki.libsdl.org/SDL3/SDL_ReadAsyncIO
+func ReadAsyncIO(asyncio *AsyncIO, ptr unsafe.Pointer, offset uint64, size uint64, queue *AsyncIOQueue, userdata unsafe.Pointer) bool {
+	return sdlReadAsyncIO(asyncio, ptr, offset, size, queue, userdata)
+}
 
-// func ReadAsyncIO(asyncio *AsyncIO, ptr unsafe.Pointer, offset uint64, size uint64, queue *AsyncIOQueue, userdata unsafe.Pointer) bool {
-//	return sdlReadAsyncIO(asyncio, ptr, offset, size, queue, userdata)
-// }
+// [SignalAsyncIOQueue] wakes up any threads that are blocking in [WaitAsyncIOResult].
+//
+// [SignalAsyncIOQueue]: https://wiki.libsdl.org/SDL3/SDL_SignalAsyncIOQueue
+func SignalAsyncIOQueue(queue *AsyncIOQueue) {
+	sdlSignalAsyncIOQueue(queue)
+}
 
-// func SignalAsyncIOQueue(queue *AsyncIOQueue)  {
-//	sdlSignalAsyncIOQueue(queue)
-// }
+// [WaitAsyncIOResult] blocks until an async I/O task queue has a completed task or the timeout expires.
+//
+// [WaitAsyncIOResult]: https://wiki.libsdl.org/SDL3/SDL_WaitAsyncIOResult
+func WaitAsyncIOResult(queue *AsyncIOQueue, outcome *AsyncIOOutcome, timeoutMS int32) bool {
+	return sdlWaitAsyncIOResult(queue, outcome, timeoutMS)
+}
 
-// func WaitAsyncIOResult(queue *AsyncIOQueue, outcome *AsyncIOOutcome, timeoutMS int32) bool {
-//	return sdlWaitAsyncIOResult(queue, outcome, timeoutMS)
-// }
+// [WriteAsyncIO] starts an async write. The buffer must stay valid until the task is complete.
+//
+// [WriteAsyncIO]: https://wiki.libsdl.org/SDL3/SDL_WriteAsyncIO
+func WriteAsyncIO(asyncio *AsyncIO, ptr unsafe.Pointer, offset uint64, size uint64, queue *AsyncIOQueue, userdata unsafe.Pointer) bool {
+	return sdlWriteAsyncIO(asyncio, ptr, offset, size, queue, userdata)
+}
 
-// func WriteAsyncIO(asyncio *AsyncIO, ptr unsafe.Pointer, offset uint64, size uint64, queue *AsyncIOQueue, userdata unsafe.Pointer) bool {
-//	return sdlWriteAsyncIO(asyncio, ptr, offset, size, queue, userdata)
-// }
+// [AsyncIOOutcome] is information about a completed asynchronous I/O request.
+//
+// [AsyncIOOutcome]: https://wiki.libsdl.org/SDL3/SDL_AsyncIOOutcome
+type AsyncIOOutcome struct {
+	AsyncIO          *AsyncIO        // What generated this task. This pointer will be invalid if it was closed!
+	Type             AsyncIOTaskType // What sort of task was this? Read, write, etc?
+	Result           AsyncIOResult   // The result of the work (success, failure, cancellation).
+	Buffer           unsafe.Pointer  // Buffer where data was read/written.
+	Offset           uint64          // Offset in the AsyncIO where data was read/written.
+	BytesRequested   uint64          // Number of bytes the task was to read/write.
+	BytesTransferred uint64          // Actual number of bytes that were read/written.
+	Userdata         unsafe.Pointer  // Pointer provided by the app when starting the task.
+}
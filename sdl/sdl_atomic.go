@@ -1,5 +1,7 @@
 package sdl
 
+import "unsafe"
+
 // [SpinLock] is an atomic spinlock.
 //
 // [SpinLock]: https://wiki.libsdl.org/SDL3/SDL_SpinLock
@@ -19,71 +21,120 @@ type AtomicU32 struct {
 	Value uint32
 }
 
-// func AddAtomicInt(a *AtomicInt, v int32) int32 {
-//	return sdlAddAtomicInt(a, v)
-// }
+// [AddAtomicInt] atomically adds v to an atomic variable and returns the previous value.
+//
+// [AddAtomicInt]: https://wiki.libsdl.org/SDL3/SDL_AddAtomicInt
+func AddAtomicInt(a *AtomicInt, v int32) int32 {
+	return sdlAddAtomicInt(a, v)
+}
 
-// func CompareAndSwapAtomicInt(a *AtomicInt, oldval int32, newval int32) bool {
-//	return sdlCompareAndSwapAtomicInt(a, oldval, newval)
-// }
+// [CompareAndSwapAtomicInt] sets an atomic variable to newval if it is currently oldval and reports whether it did.
+//
+// [CompareAndSwapAtomicInt]: https://wiki.libsdl.org/SDL3/SDL_CompareAndSwapAtomicInt
+func CompareAndSwapAtomicInt(a *AtomicInt, oldval int32, newval int32) bool {
+	return sdlCompareAndSwapAtomicInt(a, oldval, newval)
+}
 
-// func CompareAndSwapAtomicPointer(a *unsafe.Pointer, oldval unsafe.Pointer, newval unsafe.Pointer) bool {
-//	return sdlCompareAndSwapAtomicPointer(a, oldval, newval)
-// }
+// [CompareAndSwapAtomicPointer] sets a pointer to newval if it is currently oldval and reports whether it did.
+//
+// [CompareAndSwapAtomicPointer]: https://wiki.libsdl.org/SDL3/SDL_CompareAndSwapAtomicPointer
+func CompareAndSwapAtomicPointer(a *unsafe.Pointer, oldval unsafe.Pointer, newval unsafe.Pointer) bool {
+	return sdlCompareAndSwapAtomicPointer(a, oldval, newval)
+}
 
-// func CompareAndSwapAtomicU32(a *AtomicU32, oldval uint32, newval uint32) bool {
-//	return sdlCompareAndSwapAtomicU32(a, oldval, newval)
-// }
+// [CompareAndSwapAtomicU32] sets an atomic variable to newval if it is currently oldval and reports whether it did.
+//
+// [CompareAndSwapAtomicU32]: https://wiki.libsdl.org/SDL3/SDL_CompareAndSwapAtomicU32
+func CompareAndSwapAtomicU32(a *AtomicU32, oldval uint32, newval uint32) bool {
+	return sdlCompareAndSwapAtomicU32(a, oldval, newval)
+}
 
-// func GetAtomicInt(a *AtomicInt) int32 {
-//	return sdlGetAtomicInt(a)
-// }
+// [GetAtomicInt] gets the value of an atomic variable.
+//
+// [GetAtomicInt]: https://wiki.libsdl.org/SDL3/SDL_GetAtomicInt
+func GetAtomicInt(a *AtomicInt) int32 {
+	return sdlGetAtomicInt(a)
+}
 
-// func GetAtomicPointer(a *unsafe.Pointer) unsafe.Pointer {
-//	return sdlGetAtomicPointer(a)
-// }
+// [GetAtomicPointer] gets the value of a pointer atomically.
+//
+// [GetAtomicPointer]: https://wiki.libsdl.org/SDL3/SDL_GetAtomicPointer
+func GetAtomicPointer(a *unsafe.Pointer) unsafe.Pointer {
+	return sdlGetAtomicPointer(a)
+}
 
-// func GetAtomicU32(a *AtomicU32) uint32 {
-//	return sdlGetAtomicU32(a)
-// }
+// [GetAtomicU32] gets the value of an atomic variable.
+//
+// [GetAtomicU32]: https://wiki.libsdl.org/SDL3/SDL_GetAtomicU32
+func GetAtomicU32(a *AtomicU32) uint32 {
+	return sdlGetAtomicU32(a)
+}
 
-// [AddAtomicU32] adds to an atomic variable.
+// [AddAtomicU32] atomically adds v to an atomic variable and returns the previous value.
 //
 // Available since SDL 3.4.0.
 //
 // [AddAtomicU32]: https://wiki.libsdl.org/SDL3/SDL_AddAtomicU32
-// func AddAtomicU32(a *AtomicU32, v int32) uint32 {
-// 	return sdlAddAtomicU32(a, v)
-// }
+func AddAtomicU32(a *AtomicU32, v int32) uint32 {
+	if sdlAddAtomicU32 == nil {
+		notAvailable("SDL_AddAtomicU32")
+		return 0
+	}
+	return sdlAddAtomicU32(a, v)
+}
 
-// func LockSpinlock(lock *SpinLock)  {
-//	sdlLockSpinlock(lock)
-// }
+// [LockSpinlock] locks a spin lock by setting it to a non-zero value.
+//
+// [LockSpinlock]: https://wiki.libsdl.org/SDL3/SDL_LockSpinlock
+func LockSpinlock(lock *SpinLock) {
+	sdlLockSpinlock(lock)
+}
 
-// func MemoryBarrierAcquireFunction()  {
-//	sdlMemoryBarrierAcquireFunction()
-// }
+// [MemoryBarrierAcquireFunction] inserts a memory acquire barrier.
+//
+// [MemoryBarrierAcquireFunction]: https://wiki.libsdl.org/SDL3/SDL_MemoryBarrierAcquireFunction
+func MemoryBarrierAcquireFunction() {
+	sdlMemoryBarrierAcquireFunction()
+}
 
-// func MemoryBarrierReleaseFunction()  {
-//	sdlMemoryBarrierReleaseFunction()
-// }
+// [MemoryBarrierReleaseFunction] inserts a memory release barrier.
+//
+// [MemoryBarrierReleaseFunction]: https://wiki.libsdl.org/SDL3/SDL_MemoryBarrierReleaseFunction
+func MemoryBarrierReleaseFunction() {
+	sdlMemoryBarrierReleaseFunction()
+}
 
-// func SetAtomicInt(a *AtomicInt, v int32) int32 {
-//	return sdlSetAtomicInt(a, v)
-// }
+// [SetAtomicInt] sets an atomic variable to a value and returns the previous value.
+//
+// [SetAtomicInt]: https://wiki.libsdl.org/SDL3/SDL_SetAtomicInt
+func SetAtomicInt(a *AtomicInt, v int32) int32 {
+	return sdlSetAtomicInt(a, v)
+}
 
-// func SetAtomicPointer(a *unsafe.Pointer, v unsafe.Pointer) unsafe.Pointer {
-//	return sdlSetAtomicPointer(a, v)
-// }
+// [SetAtomicPointer] sets a pointer to a value atomically and returns the previous value.
+//
+// [SetAtomicPointer]: https://wiki.libsdl.org/SDL3/SDL_SetAtomicPointer
+func SetAtomicPointer(a *unsafe.Pointer, v unsafe.Pointer) unsafe.Pointer {
+	return sdlSetAtomicPointer(a, v)
+}
 
-// func SetAtomicU32(a *AtomicU32, v uint32) uint32 {
-//	return sdlSetAtomicU32(a, v)
-// }
+// [SetAtomicU32] sets an atomic variable to a value and returns the previous value.
+//
+// [SetAtomicU32]: https://wiki.libsdl.org/SDL3/SDL_SetAtomicU32
+func SetAtomicU32(a *AtomicU32, v uint32) uint32 {
+	return sdlSetAtomicU32(a, v)
+}
 
-// func TryLockSpinlock(lock *SpinLock) bool {
-//	return sdlTryLockSpinlock(lock)
-// }
+// [TryLockSpinlock] tries to lock a spin lock by setting it to a non-zero value and reports whether it did.
+//
+// [TryLockSpinlock]: https://wiki.libsdl.org/SDL3/SDL_TryLockSpinlock
+func TryLockSpinlock(lock *SpinLock) bool {
+	return sdlTryLockSpinlock(lock)
+}
 
-// func UnlockSpinlock(lock *SpinLock)  {
-//	sdlUnlockSpinlock(lock)
-// }
+// [UnlockSpinlock] unlocks a spin lock by setting it to 0.
+//
+// [UnlockSpinlock]: https://wiki.libsdl.org/SDL3/SDL_UnlockSpinlock
+func UnlockSpinlock(lock *SpinLock) {
+	sdlUnlockSpinlock(lock)
+}
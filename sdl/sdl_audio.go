@@ -64,6 +64,13 @@ type AudioStreamCallback uintptr
 // [AudioStreamDataCompleteCallback]: https://wiki.libsdl.org/SDL3/SDL_AudioStreamDataCompleteCallback
 type AudioStreamDataCompleteCallback uintptr
 
+// [AudioPostmixCallback] is a callback that fires when data is about to be fed to an audio device.
+//
+// [AudioPostmixCallback]: https://wiki.libsdl.org/SDL3/SDL_AudioPostmixCallback
+type AudioPostmixCallback uintptr
+
+// NewAudioStreamCallback converts the Go function to a C function pointer, which is never freed.
+// Prefer [OpenAudioDeviceStreamFunc] when streams are opened repeatedly.
 func NewAudioStreamCallback(callback func(userdata unsafe.Pointer, stream *AudioStream, additionalAmount, totalAmount int32)) AudioStreamCallback {
 	cb := purego.NewCallback(func(userdata unsafe.Pointer, stream *AudioStream, additionalAmount, totalAmount int32) uintptr {
 		callback(userdata, stream, additionalAmount, totalAmount)
@@ -73,9 +80,29 @@ func NewAudioStreamCallback(callback func(userdata unsafe.Pointer, stream *Audio
 	return AudioStreamCallback(cb)
 }
 
-// func AudioDevicePaused(dev AudioDeviceID) bool {
-//	return sdlAudioDevicePaused(dev)
-// }
+var audioStreamTrampoline trampoline
+
+func audioStreamCallbackFunc() AudioStreamCallback {
+	return AudioStreamCallback(audioStreamTrampoline.get(func(userdata uintptr, stream *AudioStream, additionalAmount, totalAmount int32) uintptr {
+		if callback, ok := lookupCallback(userdata).(func(*AudioStream, int32, int32)); ok {
+			callback(stream, additionalAmount, totalAmount)
+		}
+		return 0
+	}))
+}
+
+// OpenAudioDeviceStreamFunc is like [OpenAudioDeviceStream], but takes a Go function, which runs on the audio thread.
+//
+// Release the returned handle after [DestroyAudioStream]. On failure the stream and the handle are nil.
+func OpenAudioDeviceStreamFunc(devid AudioDeviceID, spec *AudioSpec, callback func(stream *AudioStream, additionalAmount, totalAmount int32)) (*AudioStream, *CallbackHandle) {
+	h := newCallbackHandle(callback, nil)
+	stream := sdlOpenAudioDeviceStream(devid, spec, audioStreamCallbackFunc(), h.pointer())
+	if stream == nil {
+		h.Release()
+		return nil, nil
+	}
+	return stream, h
+}
 
 // [AudioStreamDevicePaused] queries if an audio device associated with a stream is paused.
 //
@@ -85,14 +112,6 @@ func AudioStreamDevicePaused(stream *AudioStream) bool {
 	return byte(ret) != 0
 }
 
-// func BindAudioStream(devid AudioDeviceID, stream *AudioStream) bool {
-//	return sdlBindAudioStream(devid, stream)
-// }
-
-// func BindAudioStreams(devid AudioDeviceID, streams **AudioStream, num_streams int32) bool {
-//	return sdlBindAudioStreams(devid, streams, num_streams)
-// }
-
 // [ClearAudioStream] clears any pending data in the stream.
 //
 // [ClearAudioStream]: https://wiki.libsdl.org/SDL3/SDL_ClearAudioStream
@@ -101,18 +120,6 @@ func ClearAudioStream(stream *AudioStream) bool {
 	return byte(ret) != 0
 }
 
-// func CloseAudioDevice(devid AudioDeviceID)  {
-//	sdlCloseAudioDevice(devid)
-// }
-
-// func ConvertAudioSamples(src_spec *AudioSpec, src_data *uint8, src_len int32, dst_spec *AudioSpec, dst_data **uint8, dst_len *int32) bool {
-//	return sdlConvertAudioSamples(src_spec, src_data, src_len, dst_spec, dst_data, dst_len)
-// }
-
-// func CreateAudioStream(src_spec *AudioSpec, dst_spec *AudioSpec) *AudioStream {
-//	return sdlCreateAudioStream(src_spec, dst_spec)
-// }
-
 // [DestroyAudioStream] frees an audio stream.
 //
 // [DestroyAudioStream]: https://wiki.libsdl.org/SDL3/SDL_DestroyAudioStream
@@ -129,22 +136,6 @@ func FlushAudioStream(stream *AudioStream) bool {
 	return byte(ret) != 0
 }
 
-// func GetAudioDeviceChannelMap(devid AudioDeviceID, count *int32) *int32 {
-//	return sdlGetAudioDeviceChannelMap(devid, count)
-// }
-
-// func GetAudioDeviceFormat(devid AudioDeviceID, spec *AudioSpec, sample_frames *int32) bool {
-//	return sdlGetAudioDeviceFormat(devid, spec, sample_frames)
-// }
-
-// func GetAudioDeviceGain(devid AudioDeviceID) float32 {
-//	return sdlGetAudioDeviceGain(devid)
-// }
-
-// func GetAudioDeviceName(devid AudioDeviceID) string {
-//	return sdlGetAudioDeviceName(devid)
-// }
-
 // [GetAudioDriver] gets the name of a built in audio driver.
 //
 // [GetAudioDriver]: https://wiki.libsdl.org/SDL3/SDL_GetAudioDriver
@@ -152,26 +143,6 @@ func GetAudioDriver(index int32) string {
 	return sdlGetAudioDriver(index)
 }
 
-// func GetAudioFormatName(format AudioFormat) string {
-//	return sdlGetAudioFormatName(format)
-// }
-
-// func GetAudioPlaybackDevices(count *int32) *AudioDeviceID {
-//	return sdlGetAudioPlaybackDevices(count)
-// }
-
-// func GetAudioRecordingDevices(count *int32) *AudioDeviceID {
-//	return sdlGetAudioRecordingDevices(count)
-// }
-
-// func GetAudioStreamAvailable(stream *AudioStream) int32 {
-//	return sdlGetAudioStreamAvailable(stream)
-// }
-
-// func GetAudioStreamData(stream *AudioStream, buf unsafe.Pointer, len int32) int32 {
-//	return sdlGetAudioStreamData(stream, buf, len)
-// }
-
 // [GetAudioStreamDevice] queries an audio stream for its currently-bound device.
 //
 // [GetAudioStreamDevice]: https://wiki.libsdl.org/SDL3/SDL_GetAudioStreamDevice
@@ -200,18 +171,6 @@ func GetAudioStreamGain(stream *AudioStream) float32 {
 	return sdlGetAudioStreamGain(stream)
 }
 
-// func GetAudioStreamInputChannelMap(stream *AudioStream, count *int32) *int32 {
-//	return sdlGetAudioStreamInputChannelMap(stream, count)
-// }
-
-// func GetAudioStreamOutputChannelMap(stream *AudioStream, count *int32) *int32 {
-//	return sdlGetAudioStreamOutputChannelMap(stream, count)
-// }
-
-// func GetAudioStreamProperties(stream *AudioStream) PropertiesID {
-//	return sdlGetAudioStreamProperties(stream)
-// }
-
 // [GetAudioStreamQueued] gets the number of bytes currently queued.
 //
 // [GetAudioStreamQueued]: https://wiki.libsdl.org/SDL3/SDL_GetAudioStreamQueued
@@ -234,18 +193,6 @@ func GetNumAudioDrivers() int32 {
 	return sdlGetNumAudioDrivers()
 }
 
-// func GetSilenceValueForFormat(format AudioFormat) int32 {
-//	return sdlGetSilenceValueForFormat(format)
-// }
-
-// func IsAudioDevicePhysical(devid AudioDeviceID) bool {
-//	return sdlIsAudioDevicePhysical(devid)
-// }
-
-// func IsAudioDevicePlayback(devid AudioDeviceID) bool {
-//	return sdlIsAudioDevicePlayback(devid)
-// }
-
 // [LoadWAV] loads a WAV from a file path.
 // The data returned in audioBuf should be disposed with [Free] when it is no longer needed.
 //
@@ -262,18 +209,6 @@ func LoadWAVIO(src *IOStream, closeio bool, spec *AudioSpec, audioBuf **uint8, a
 	return sdlLoadWAVIO(src, closeio, spec, audioBuf, audioLen)
 }
 
-// func LockAudioStream(stream *AudioStream) bool {
-//	return sdlLockAudioStream(stream)
-// }
-
-// func MixAudio(dst *uint8, src *uint8, format AudioFormat, len uint32, volume float32) bool {
-//	return sdlMixAudio(dst, src, format, len, volume)
-// }
-
-// func OpenAudioDevice(devid AudioDeviceID, spec *AudioSpec) AudioDeviceID {
-//	return sdlOpenAudioDevice(devid, spec)
-// }
-
 // [OpenAudioDeviceStream] returns an audio stream on success, ready to use, or nil on failure.
 // When done with this stream, call [DestroyAudioStream] to free resources and close the device.
 //
@@ -282,10 +217,6 @@ func OpenAudioDeviceStream(devid AudioDeviceID, spec *AudioSpec, callback AudioS
 	return sdlOpenAudioDeviceStream(devid, spec, callback, userdata)
 }
 
-// func PauseAudioDevice(dev AudioDeviceID) bool {
-//	return sdlPauseAudioDevice(dev)
-// }
-
 // [PauseAudioStreamDevice] pauses audio playback on the audio device associated with an audio stream.
 //
 // [PauseAudioStreamDevice]: https://wiki.libsdl.org/SDL3/SDL_PauseAudioStreamDevice
@@ -322,10 +253,6 @@ func PutAudioStreamData(stream *AudioStream, buf *uint8, len int32) bool {
 // 	return byte(ret) != 0
 // }
 
-// func ResumeAudioDevice(dev AudioDeviceID) bool {
-//	return sdlResumeAudioDevice(dev)
-// }
-
 // [ResumeAudioStreamDevice] unpauses audio playback on the audio device associated with an audio stream.
 //
 // [ResumeAudioStreamDevice]: https://wiki.libsdl.org/SDL3/SDL_ResumeAudioStreamDevice
@@ -334,14 +261,6 @@ func ResumeAudioStreamDevice(stream *AudioStream) bool {
 	return byte(ret) != 0
 }
 
-// func SetAudioDeviceGain(devid AudioDeviceID, gain float32) bool {
-//	return sdlSetAudioDeviceGain(devid, gain)
-// }
-
-// func SetAudioPostmixCallback(devid AudioDeviceID, callback AudioPostmixCallback, userdata unsafe.Pointer) bool {
-//	return sdlSetAudioPostmixCallback(devid, callback, userdata)
-// }
-
 // [SetAudioStreamFormat] changes the input and output formats of an audio stream.
 //
 // [SetAudioStreamFormat]: https://wiki.libsdl.org/SDL3/SDL_SetAudioStreamFormat
@@ -362,31 +281,3 @@ func SetAudioStreamFrequencyRatio(stream *AudioStream, ratio float32) bool {
 func SetAudioStreamGain(stream *AudioStream, gain float32) bool {
 	return sdlSetAudioStreamGain(stream, gain)
 }
-
-// func SetAudioStreamGetCallback(stream *AudioStream, callback AudioStreamCallback, userdata unsafe.Pointer) bool {
-//	return sdlSetAudioStreamGetCallback(stream, callback, userdata)
-// }
-
-// func SetAudioStreamInputChannelMap(stream *AudioStream, chmap *int32, count int32) bool {
-//	return sdlSetAudioStreamInputChannelMap(stream, chmap, count)
-// }
-
-// func SetAudioStreamOutputChannelMap(stream *AudioStream, chmap *int32, count int32) bool {
-//	return sdlSetAudioStreamOutputChannelMap(stream, chmap, count)
-// }
-
-// func SetAudioStreamPutCallback(stream *AudioStream, callback AudioStreamCallback, userdata unsafe.Pointer) bool {
-//	return sdlSetAudioStreamPutCallback(stream, callback, userdata)
-// }
-
-// func UnbindAudioStream(stream *AudioStream)  {
-//	sdlUnbindAudioStream(stream)
-// }
-
-// func UnbindAudioStreams(streams **AudioStream, num_streams int32)  {
-//	sdlUnbindAudioStreams(streams, num_streams)
-// }
-
-// func UnlockAudioStream(stream *AudioStream) bool {
-//	return sdlUnlockAudioStream(stream)
-// }
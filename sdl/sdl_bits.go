@@ -1,9 +0,0 @@
-package sdl
-
-// func HasExactlyOneBitSet32(x uint32) bool {
-//	return sdlHasExactlyOneBitSet32(x)
-// }
-
-// func MostSignificantBitIndex32(x uint32) int32 {
-//	return sdlMostSignificantBitIndex32(x)
-// }
@@ -6,17 +6,17 @@ import (
 	"github.com/jupiterrider/purego-sdl3/internal/convert"
 )
 
-// func ClearClipboardData() bool {
-//	return sdlClearClipboardData()
-// }
-
-// func GetClipboardData(mime_type string, size *uint64) unsafe.Pointer {
-//	return sdlGetClipboardData(mime_type, size)
-// }
+// [ClipboardDataCallback] is a callback function that will be called when data for the specified mime-type
+// is requested by the OS.
+//
+// [ClipboardDataCallback]: https://wiki.libsdl.org/SDL3/SDL_ClipboardDataCallback
+type ClipboardDataCallback uintptr
 
-// func GetClipboardMimeTypes(num_mime_types *uint64) **byte {
-//	return sdlGetClipboardMimeTypes(num_mime_types)
-// }
+// [ClipboardCleanupCallback] is a callback function that will be called when the clipboard is cleared,
+// or when new data is set.
+//
+// [ClipboardCleanupCallback]: https://wiki.libsdl.org/SDL3/SDL_ClipboardCleanupCallback
+type ClipboardCleanupCallback uintptr
 
 // [GetClipboardText] gets UTF-8 text from the clipboard.
 //
@@ -27,30 +27,11 @@ func GetClipboardText() string {
 	return convert.ToString(ret)
 }
 
-// func GetPrimarySelectionText() string {
-//	return sdlGetPrimarySelectionText()
-// }
-
-// func HasClipboardData(mime_type string) bool {
-//	return sdlHasClipboardData(mime_type)
-// }
-
-// func HasClipboardText() bool {
-//	return sdlHasClipboardText()
-// }
-
-// func HasPrimarySelectionText() bool {
-//	return sdlHasPrimarySelectionText()
-// }
-
-// func SetClipboardData(callback ClipboardDataCallback, cleanup ClipboardCleanupCallback, userdata unsafe.Pointer, mime_types **byte, num_mime_types uint64) bool {
-//	return sdlSetClipboardData(callback, cleanup, userdata, mime_types, num_mime_types)
-// }
-
-// func SetClipboardText(text string) bool {
-//	return sdlSetClipboardText(text)
-// }
-
-// func SetPrimarySelectionText(text string) bool {
-//	return sdlSetPrimarySelectionText(text)
-// }
+// [GetPrimarySelectionText] gets UTF-8 text from the primary selection.
+//
+// [GetPrimarySelectionText]: https://wiki.libsdl.org/SDL3/SDL_GetPrimarySelectionText
+func GetPrimarySelectionText() string {
+	ret := sdlGetPrimarySelectionText()
+	defer Free(unsafe.Pointer(ret))
+	return convert.ToString(ret)
+}
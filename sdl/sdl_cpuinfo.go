@@ -132,5 +132,9 @@ func HasSSE42() bool {
 //
 // [GetSystemPageSize]: https://wiki.libsdl.org/SDL3/SDL_GetSystemPageSize
 func GetSystemPageSize() int32 {
+	if sdlGetSystemPageSize == nil {
+		notAvailable("SDL_GetSystemPageSize")
+		return 0
+	}
 	return sdlGetSystemPageSize()
 }
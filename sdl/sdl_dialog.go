@@ -53,6 +53,8 @@ func (d DialogFileFilter) Pattern() string {
 // [DialogFileCallback]: https://wiki.libsdl.org/SDL3/SDL_DialogFileCallback
 type DialogFileCallback uintptr
 
+// NewDialogFileCallback converts the Go function to a C function pointer, which is never freed.
+// Prefer [ShowOpenFileDialogFunc] and the related functions when dialogs are shown repeatedly.
 func NewDialogFileCallback(callback func(userdata unsafe.Pointer, filelist []string, filter int32)) DialogFileCallback {
 	cb := purego.NewCallback(func(userdata unsafe.Pointer, filelist **byte, filter int32) uintptr {
 		callback(userdata, convert.ToStringSlice(filelist), filter)
@@ -62,6 +64,20 @@ func NewDialogFileCallback(callback func(userdata unsafe.Pointer, filelist []str
 	return DialogFileCallback(cb)
 }
 
+var dialogFileTrampoline trampoline
+
+// dialogFileCallbackFunc returns the shared trampoline for dialogs. SDL calls it exactly once per dialog,
+// so the handle is released afterwards.
+func dialogFileCallbackFunc() DialogFileCallback {
+	return DialogFileCallback(dialogFileTrampoline.get(func(userdata uintptr, filelist **byte, filter int32) uintptr {
+		if callback, ok := lookupCallback(userdata).(func([]string, int32)); ok {
+			callback(convert.ToStringSlice(filelist), filter)
+		}
+		releaseCallback(userdata)
+		return 0
+	}))
+}
+
 // [ShowFileDialogWithProperties] creates and launches a file dialog with the specified properties.
 //
 // [ShowFileDialogWithProperties]: https://wiki.libsdl.org/SDL3/SDL_ShowFileDialogWithProperties
@@ -89,3 +105,35 @@ func ShowOpenFolderDialog(callback DialogFileCallback, userdata unsafe.Pointer,
 func ShowSaveFileDialog(callback DialogFileCallback, userdata unsafe.Pointer, window *Window, filters []DialogFileFilter, defaultLocation string) {
 	sdlShowSaveFileDialog(callback, userdata, window, filters, int32(len(filters)), defaultLocation)
 }
+
+// ShowFileDialogWithPropertiesFunc is like [ShowFileDialogWithProperties], but takes a Go function.
+//
+// The callback is called once. A nil filelist means an error occurred; see [GetError].
+func ShowFileDialogWithPropertiesFunc(dialogType FileDialogType, callback func(filelist []string, filter int32), props PropertiesID) {
+	h := newCallbackHandle(callback, nil)
+	sdlShowFileDialogWithProperties(dialogType, dialogFileCallbackFunc(), h.pointer(), props)
+}
+
+// ShowOpenFileDialogFunc is like [ShowOpenFileDialog], but takes a Go function.
+//
+// The callback is called once. A nil filelist means an error occurred; see [GetError].
+func ShowOpenFileDialogFunc(callback func(filelist []string, filter int32), window *Window, filters []DialogFileFilter, defaultLocation string, allowMany bool) {
+	h := newCallbackHandle(callback, nil)
+	sdlShowOpenFileDialog(dialogFileCallbackFunc(), h.pointer(), window, filters, int32(len(filters)), convert.ToBytePtrNullable(defaultLocation), allowMany)
+}
+
+// ShowOpenFolderDialogFunc is like [ShowOpenFolderDialog], but takes a Go function.
+//
+// The callback is called once. A nil filelist means an error occurred; see [GetError].
+func ShowOpenFolderDialogFunc(callback func(filelist []string, filter int32), window *Window, defaultLocation string, allowMany bool) {
+	h := newCallbackHandle(callback, nil)
+	sdlShowOpenFolderDialog(dialogFileCallbackFunc(), h.pointer(), window, defaultLocation, allowMany)
+}
+
+// ShowSaveFileDialogFunc is like [ShowSaveFileDialog], but takes a Go function.
+//
+// The callback is called once. A nil filelist means an error occurred; see [GetError].
+func ShowSaveFileDialogFunc(callback func(filelist []string, filter int32), window *Window, filters []DialogFileFilter, defaultLocation string) {
+	h := newCallbackHandle(callback, nil)
+	sdlShowSaveFileDialog(dialogFileCallbackFunc(), h.pointer(), window, filters, int32(len(filters)), defaultLocation)
+}
@@ -1,5 +0,0 @@
-package sdl
-
-// func SwapFloat(x float32) float32 {
-//	return sdlSwapFloat(x)
-// }
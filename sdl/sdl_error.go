@@ -16,10 +16,6 @@ func ClearError() bool {
 	return sdlClearError()
 }
 
-// func OutOfMemory() bool {
-//	return sdlOutOfMemory()
-// }
-
 // [SetError] sets the SDL error message for the current thread.
 //
 // [SetError]: https://wiki.libsdl.org/SDL3/SDL_SetError
@@ -27,10 +23,6 @@ func SetError(format string, a ...any) bool {
 	return sdlSetError(fmt.Sprintf(format, a...))
 }
 
-// func SetErrorV(fmt string, ap va_list) bool {
-//	return sdlSetErrorV(fmt, ap)
-// }
-
 // [InvalidParamError] sets the SDL error message, standardizes error reporting on unsupported operations.
 //
 // [InvalidParamError]: https://wiki.libsdl.org/SDL3/SDL_InvalidParamError
@@ -908,7 +908,8 @@ type UserEvent struct {
 // [EventFilter]: https://wiki.libsdl.org/SDL3/SDL_EventFilter
 type EventFilter uintptr
 
-// NewEventFilter converts the Go function to a C function pointer.
+// NewEventFilter converts the Go function to a C function pointer, which is never freed.
+// Prefer [AddEventWatchFunc], [SetEventFilterFunc] or [FilterEventsFunc] when filters are added repeatedly.
 func NewEventFilter(filter func(userdata unsafe.Pointer, event *Event) bool) EventFilter {
 	// workaround to avoid panic "expected function with one uintptr-sized result" on Windows
 	cb := purego.NewCallback(func(userdata unsafe.Pointer, event *Event) uintptr {
@@ -921,6 +922,55 @@ func NewEventFilter(filter func(userdata unsafe.Pointer, event *Event) bool) Eve
 	return EventFilter(cb)
 }
 
+var eventFilterTrampoline trampoline
+
+func eventFilterFunc() EventFilter {
+	return EventFilter(eventFilterTrampoline.get(func(userdata uintptr, event *Event) uintptr {
+		if filter, ok := lookupCallback(userdata).(func(*Event) bool); ok && !filter(event) {
+			return 0
+		}
+		return 1
+	}))
+}
+
+// AddEventWatchFunc is like [AddEventWatch], but takes a Go function. Release the returned handle to remove the watch.
+//
+// The callback may run on a different thread than the one that pushed the event.
+func AddEventWatchFunc(filter func(event *Event) bool) (*CallbackHandle, bool) {
+	var h *CallbackHandle
+	h = newCallbackHandle(filter, func() {
+		sdlRemoveEventWatch(eventFilterFunc(), h.pointer())
+	})
+	if !sdlAddEventWatch(eventFilterFunc(), h.pointer()) {
+		h.release = nil
+		h.Release()
+		return nil, false
+	}
+	return h, true
+}
+
+// SetEventFilterFunc is like [SetEventFilter], but takes a Go function.
+// Releasing the returned handle removes the filter, unless it has been replaced in the meantime.
+func SetEventFilterFunc(filter func(event *Event) bool) *CallbackHandle {
+	var h *CallbackHandle
+	h = newCallbackHandle(filter, func() {
+		var current EventFilter
+		var userdata unsafe.Pointer
+		if sdlGetEventFilter(&current, &userdata) && userdata == h.pointer() {
+			sdlSetEventFilter(0, nil)
+		}
+	})
+	sdlSetEventFilter(eventFilterFunc(), h.pointer())
+	return h
+}
+
+// FilterEventsFunc is like [FilterEvents], but takes a Go function.
+func FilterEventsFunc(filter func(event *Event) bool) {
+	h := newCallbackHandle(filter, nil)
+	defer h.Release()
+	sdlFilterEvents(eventFilterFunc(), h.pointer())
+}
+
 // [PollEvent] polls for currently pending events.
 //
 // [PollEvent]: https://wiki.libsdl.org/SDL3/SDL_PollEvent
@@ -978,15 +1028,6 @@ func GetWindowFromEvent(event *Event) *Window {
 	return sdlGetWindowFromEvent(event)
 }
 
-// [GetEventDescription] generates an English description of an event.
-//
-// Available since SDL 3.4.0.
-//
-// [GetEventDescription]: https://wiki.libsdl.org/SDL3/SDL_GetEventDescription
-// func GetEventDescription(event *Event, buf *byte, buflen int32) int32 {
-// 	return sdlGetEventDescription(event, buf, buflen)
-// }
-
 // [HasEvent] checks for the existence of a certain event type in the event queue.
 //
 // [HasEvent]: https://wiki.libsdl.org/SDL3/SDL_HasEvent
@@ -72,17 +72,35 @@ const (
 	FolderCount                     // Total number of types in this enum, not a folder type by itself.
 )
 
-// func CopyFile(oldpath string, newpath string) bool {
-//	return sdlCopyFile(oldpath, newpath)
-// }
+// [CopyFile] copies a file.
+//
+// [CopyFile]: https://wiki.libsdl.org/SDL3/SDL_CopyFile
+func CopyFile(oldpath string, newpath string) bool {
+	return sdlCopyFile(oldpath, newpath)
+}
 
-// func CreateDirectory(path string) bool {
-//	return sdlCreateDirectory(path)
-// }
+// [CreateDirectory] creates a directory and any missing parent directories.
+//
+// [CreateDirectory]: https://wiki.libsdl.org/SDL3/SDL_CreateDirectory
+func CreateDirectory(path string) bool {
+	return sdlCreateDirectory(path)
+}
 
-// func EnumerateDirectory(path string, callback EnumerateDirectoryCallback, userdata unsafe.Pointer) bool {
-//	return sdlEnumerateDirectory(path, callback, userdata)
-// }
+// [EnumerateDirectory] enumerates a directory through a callback function.
+//
+// [EnumerateDirectory]: https://wiki.libsdl.org/SDL3/SDL_EnumerateDirectory
+func EnumerateDirectory(path string, callback EnumerateDirectoryCallback, userdata unsafe.Pointer) bool {
+	return sdlEnumerateDirectory(path, callback, userdata)
+}
+
+// EnumerateDirectoryFunc is like [EnumerateDirectory], but takes a Go function.
+// Returning [EnumSuccess] stops the enumeration early and [EnumFailure] makes it fail.
+// The dirname passed to the callback ends with a path separator.
+func EnumerateDirectoryFunc(path string, callback func(dirname, fname string) EnumerationResult) bool {
+	h := newCallbackHandle(callback, nil)
+	defer h.Release()
+	return sdlEnumerateDirectory(path, enumerateDirectoryCallbackFunc(), h.pointer())
+}
 
 // [GetBasePath] gets the directory where the application was run from.
 //
@@ -100,9 +118,12 @@ func GetCurrentDirectory() string {
 	return convert.ToString(ret)
 }
 
-// func GetPathInfo(path string, info *PathInfo) bool {
-//	return sdlGetPathInfo(path, info)
-// }
+// [GetPathInfo] gets information about a filesystem path. It returns false if the path doesn't exist.
+//
+// [GetPathInfo]: https://wiki.libsdl.org/SDL3/SDL_GetPathInfo
+func GetPathInfo(path string, info *PathInfo) bool {
+	return sdlGetPathInfo(path, info)
+}
 
 // [GetPrefPath] gets the user-and-app-specific path where files can be written.
 //
@@ -113,18 +134,43 @@ func GetPrefPath(org string, app string) string {
 	return convert.ToString(ret)
 }
 
-// func GetUserFolder(folder Folder) string {
-//	return sdlGetUserFolder(folder)
-// }
+// [GetUserFolder] finds the most suitable user folder for a specific purpose or returns an empty string on failure.
+// The path ends with a path separator.
+//
+// [GetUserFolder]: https://wiki.libsdl.org/SDL3/SDL_GetUserFolder
+func GetUserFolder(folder Folder) string {
+	return sdlGetUserFolder(folder)
+}
+
+// [GlobDirectory] enumerates a directory tree, filtered by pattern, and returns a list. The list must be freed with [Free]. An empty pattern matches everything.
+// See [Glob] for a version returning a slice.
+//
+// [GlobDirectory]: https://wiki.libsdl.org/SDL3/SDL_GlobDirectory
+func GlobDirectory(path string, pattern string, flags GlobFlags, count *int32) **byte {
+	return sdlGlobDirectory(path, convert.ToBytePtrNullable(pattern), flags, count)
+}
+
+// [RemovePath] removes a file or an empty directory.
+//
+// [RemovePath]: https://wiki.libsdl.org/SDL3/SDL_RemovePath
+func RemovePath(path string) bool {
+	return sdlRemovePath(path)
+}
 
-// func GlobDirectory(path string, pattern string, flags GlobFlags, count *int32) **byte {
-//	return sdlGlobDirectory(path, pattern, flags, count)
-// }
+// [RenamePath] renames a file or directory.
+//
+// [RenamePath]: https://wiki.libsdl.org/SDL3/SDL_RenamePath
+func RenamePath(oldpath string, newpath string) bool {
+	return sdlRenamePath(oldpath, newpath)
+}
 
-// func RemovePath(path string) bool {
-//	return sdlRemovePath(path)
-// }
+var enumerateDirectoryTrampoline trampoline
 
-// func RenamePath(oldpath string, newpath string) bool {
-//	return sdlRenamePath(oldpath, newpath)
-// }
+func enumerateDirectoryCallbackFunc() EnumerateDirectoryCallback {
+	return EnumerateDirectoryCallback(enumerateDirectoryTrampoline.get(func(userdata uintptr, dirname, fname *byte) uintptr {
+		if callback, ok := lookupCallback(userdata).(func(string, string) EnumerationResult); ok {
+			return uintptr(callback(convert.ToString(dirname), convert.ToString(fname)))
+		}
+		return uintptr(EnumFailure)
+	}))
+}
@@ -1107,10 +1107,6 @@ func AcquireGPUSwapchainTexture(commandBuffer *GPUCommandBuffer, window *Window,
 	return sdlAcquireGPUSwapchainTexture(commandBuffer, window, swapchainTexture, swapchainTextureWidth, swapchainTextureHeight)
 }
 
-// func BeginGPUComputePass(command_buffer *GPUCommandBuffer, storage_texture_bindings *GPUStorageTextureReadWriteBinding, num_storage_texture_bindings uint32, storage_buffer_bindings *GPUStorageBufferReadWriteBinding, num_storage_buffer_bindings uint32) *GPUComputePass {
-//	return sdlBeginGPUComputePass(command_buffer, storage_texture_bindings, num_storage_texture_bindings, storage_buffer_bindings, num_storage_buffer_bindings)
-// }
-
 // [BeginGPUCopyPass] begins a copy pass on a command buffer.
 //
 // [BeginGPUCopyPass]: https://wiki.libsdl.org/SDL3/SDL_BeginGPUCopyPass
@@ -1130,22 +1126,6 @@ func BeginGPURenderPass(commandBuffer *GPUCommandBuffer, colorTargetInfos []GPUC
 	return sdlBeginGPURenderPass(commandBuffer, colorTargetInfosPtr, numColorTargets, depthStencilTargetInfo)
 }
 
-// func BindGPUComputePipeline(compute_pass *GPUComputePass, compute_pipeline *GPUComputePipeline)  {
-//	sdlBindGPUComputePipeline(compute_pass, compute_pipeline)
-// }
-
-// func BindGPUComputeSamplers(compute_pass *GPUComputePass, first_slot uint32, texture_sampler_bindings *GPUTextureSamplerBinding, num_bindings uint32)  {
-//	sdlBindGPUComputeSamplers(compute_pass, first_slot, texture_sampler_bindings, num_bindings)
-// }
-
-// func BindGPUComputeStorageBuffers(compute_pass *GPUComputePass, first_slot uint32, storage_buffers **GPUBuffer, num_bindings uint32)  {
-//	sdlBindGPUComputeStorageBuffers(compute_pass, first_slot, storage_buffers, num_bindings)
-// }
-
-// func BindGPUComputeStorageTextures(compute_pass *GPUComputePass, first_slot uint32, storage_textures **GPUTexture, num_bindings uint32)  {
-//	sdlBindGPUComputeStorageTextures(compute_pass, first_slot, storage_textures, num_bindings)
-// }
-
 // [BindGPUFragmentSamplers] binds texture-sampler pairs for use on the fragment shader.
 //
 // [BindGPUFragmentSamplers]: https://wiki.libsdl.org/SDL3/SDL_BindGPUFragmentSamplers
@@ -1160,10 +1140,6 @@ func BindGPUFragmentStorageBuffers(renderPass *GPURenderPass, firstSlot uint32,
 	sdlBindGPUFragmentStorageBuffers(renderPass, firstSlot, storageBuffers, numBindings)
 }
 
-// func BindGPUFragmentStorageTextures(render_pass *GPURenderPass, first_slot uint32, storage_textures **GPUTexture, num_bindings uint32)  {
-//	sdlBindGPUFragmentStorageTextures(render_pass, first_slot, storage_textures, num_bindings)
-// }
-
 // [BindGPUGraphicsPipeline] binds a graphics pipeline on a render pass to be used in rendering.
 //
 // [BindGPUGraphicsPipeline]: https://wiki.libsdl.org/SDL3/SDL_BindGPUGraphicsPipeline
@@ -1185,10 +1161,6 @@ func BindGPUVertexBuffers(renderPass *GPURenderPass, firstSlot uint32, bindings
 	sdlBindGPUVertexBuffers(renderPass, firstSlot, bindings, numBindings)
 }
 
-// func BindGPUVertexSamplers(render_pass *GPURenderPass, first_slot uint32, texture_sampler_bindings *GPUTextureSamplerBinding, num_bindings uint32)  {
-//	sdlBindGPUVertexSamplers(render_pass, first_slot, texture_sampler_bindings, num_bindings)
-// }
-
 // [BindGPUVertexStorageBuffers] binds storage buffers for use on the vertex shader.
 //
 // [BindGPUVertexStorageBuffers]: https://wiki.libsdl.org/SDL3/SDL_BindGPUVertexStorageBuffers
@@ -1196,24 +1168,16 @@ func BindGPUVertexStorageBuffers(renderPass *GPURenderPass, firstSlot uint32, st
 	sdlBindGPUVertexStorageBuffers(renderPass, firstSlot, storageBuffers, numBindings)
 }
 
-// func BindGPUVertexStorageTextures(render_pass *GPURenderPass, first_slot uint32, storage_textures **GPUTexture, num_bindings uint32)  {
-//	sdlBindGPUVertexStorageTextures(render_pass, first_slot, storage_textures, num_bindings)
-// }
-
-// func BlitGPUTexture(command_buffer *GPUCommandBuffer, info *GPUBlitInfo)  {
-//	sdlBlitGPUTexture(command_buffer, info)
-// }
-
-// func CalculateGPUTextureFormatSize(format GPUTextureFormat, width uint32, height uint32, depth_or_layer_count uint32) uint32 {
-//	return sdlCalculateGPUTextureFormatSize(format, width, height, depth_or_layer_count)
-// }
-
 // [GetPixelFormatFromGPUTextureFormat] gets the SDL pixel format corresponding to a GPU texture format.
 //
 // Available since SDL 3.4.0.
 //
 // [GetPixelFormatFromGPUTextureFormat]: https://wiki.libsdl.org/SDL3/SDL_GetPixelFormatFromGPUTextureFormat
 func GetPixelFormatFromGPUTextureFormat(format GPUTextureFormat) PixelFormat {
+	if sdlGetPixelFormatFromGPUTextureFormat == nil {
+		notAvailable("SDL_GetPixelFormatFromGPUTextureFormat")
+		return PixelFormatUnknown
+	}
 	return sdlGetPixelFormatFromGPUTextureFormat(format)
 }
 
@@ -1223,13 +1187,13 @@ func GetPixelFormatFromGPUTextureFormat(format GPUTextureFormat) PixelFormat {
 //
 // [GetGPUTextureFormatFromPixelFormat]: https://wiki.libsdl.org/SDL3/SDL_GetGPUTextureFormatFromPixelFormat
 func GetGPUTextureFormatFromPixelFormat(format PixelFormat) GPUTextureFormat {
+	if sdlGetGPUTextureFormatFromPixelFormat == nil {
+		notAvailable("SDL_GetGPUTextureFormatFromPixelFormat")
+		return GPUTextureFormatInvalid
+	}
 	return sdlGetGPUTextureFormatFromPixelFormat(format)
 }
 
-// func CancelGPUCommandBuffer(command_buffer *GPUCommandBuffer) bool {
-//	return sdlCancelGPUCommandBuffer(command_buffer)
-// }
-
 // [ClaimWindowForGPUDevice] claims a window, creating a swapchain structure for it.
 //
 // [ClaimWindowForGPUDevice]: https://wiki.libsdl.org/SDL3/SDL_ClaimWindowForGPUDevice
@@ -1237,14 +1201,6 @@ func ClaimWindowForGPUDevice(device *GPUDevice, window *Window) bool {
 	return sdlClaimWindowForGPUDevice(device, window)
 }
 
-// func CopyGPUBufferToBuffer(copy_pass *GPUCopyPass, source *GPUBufferLocation, destination *GPUBufferLocation, size uint32, cycle bool)  {
-//	sdlCopyGPUBufferToBuffer(copy_pass, source, destination, size, cycle)
-// }
-
-// func CopyGPUTextureToTexture(copy_pass *GPUCopyPass, source *GPUTextureLocation, destination *GPUTextureLocation, w uint32, h uint32, d uint32, cycle bool)  {
-//	sdlCopyGPUTextureToTexture(copy_pass, source, destination, w, h, d, cycle)
-// }
-
 // [CreateGPUBuffer] creates a buffer object to be used in graphics or compute workflows.
 //
 // [CreateGPUBuffer]: https://wiki.libsdl.org/SDL3/SDL_CreateGPUBuffer
@@ -1252,10 +1208,6 @@ func CreateGPUBuffer(device *GPUDevice, createinfo *GPUBufferCreateInfo) *GPUBuf
 	return sdlCreateGPUBuffer(device, createinfo)
 }
 
-// func CreateGPUComputePipeline(device *GPUDevice, createinfo *GPUComputePipelineCreateInfo) *GPUComputePipeline {
-//	return sdlCreateGPUComputePipeline(device, createinfo)
-// }
-
 // [CreateGPUDevice] creates a GPU context.
 //
 // [CreateGPUDevice]: https://wiki.libsdl.org/SDL3/SDL_CreateGPUDevice
@@ -1263,10 +1215,6 @@ func CreateGPUDevice(formatFlags GPUShaderFormat, debugMode bool, name string) *
 	return sdlCreateGPUDevice(formatFlags, debugMode, convert.ToBytePtrNullable(name))
 }
 
-// func CreateGPUDeviceWithProperties(props PropertiesID) *GPUDevice {
-//	return sdlCreateGPUDeviceWithProperties(props)
-// }
-
 // [CreateGPUGraphicsPipeline] creates a pipeline object to be used in a graphics workflow.
 //
 // [CreateGPUGraphicsPipeline]: https://wiki.libsdl.org/SDL3/SDL_CreateGPUGraphicsPipeline
@@ -1309,22 +1257,6 @@ func DestroyGPUDevice(device *GPUDevice) {
 	sdlDestroyGPUDevice(device)
 }
 
-// func DispatchGPUCompute(compute_pass *GPUComputePass, groupcount_x uint32, groupcount_y uint32, groupcount_z uint32)  {
-//	sdlDispatchGPUCompute(compute_pass, groupcount_x, groupcount_y, groupcount_z)
-// }
-
-// func DispatchGPUComputeIndirect(compute_pass *GPUComputePass, buffer *GPUBuffer, offset uint32)  {
-//	sdlDispatchGPUComputeIndirect(compute_pass, buffer, offset)
-// }
-
-// func DownloadFromGPUBuffer(copy_pass *GPUCopyPass, source *GPUBufferRegion, destination *GPUTransferBufferLocation)  {
-//	sdlDownloadFromGPUBuffer(copy_pass, source, destination)
-// }
-
-// func DownloadFromGPUTexture(copy_pass *GPUCopyPass, source *GPUTextureRegion, destination *GPUTextureTransferInfo)  {
-//	sdlDownloadFromGPUTexture(copy_pass, source, destination)
-// }
-
 // [DrawGPUIndexedPrimitives] draws data using bound graphics state with an index buffer and instancing enabled.
 //
 // [DrawGPUIndexedPrimitives]: https://wiki.libsdl.org/SDL3/SDL_DrawGPUIndexedPrimitives
@@ -1332,10 +1264,6 @@ func DrawGPUIndexedPrimitives(renderPass *GPURenderPass, numIndices uint32, numI
 	sdlDrawGPUIndexedPrimitives(renderPass, numIndices, numInstances, firstIndex, vertexOffset, firstInstance)
 }
 
-// func DrawGPUIndexedPrimitivesIndirect(render_pass *GPURenderPass, buffer *GPUBuffer, offset uint32, draw_count uint32)  {
-//	sdlDrawGPUIndexedPrimitivesIndirect(render_pass, buffer, offset, draw_count)
-// }
-
 // [DrawGPUPrimitives] draws data using bound graphics state.
 //
 // [DrawGPUPrimitives]: https://wiki.libsdl.org/SDL3/SDL_DrawGPUPrimitives
@@ -1343,14 +1271,6 @@ func DrawGPUPrimitives(renderPass *GPURenderPass, numVertices uint32, numInstanc
 	sdlDrawGPUPrimitives(renderPass, numVertices, numInstances, firstVertex, firstInstance)
 }
 
-// func DrawGPUPrimitivesIndirect(render_pass *GPURenderPass, buffer *GPUBuffer, offset uint32, draw_count uint32)  {
-//	sdlDrawGPUPrimitivesIndirect(render_pass, buffer, offset, draw_count)
-// }
-
-// func EndGPUComputePass(compute_pass *GPUComputePass)  {
-//	sdlEndGPUComputePass(compute_pass)
-// }
-
 // [EndGPUCopyPass] ends the current copy pass.
 //
 // [EndGPUCopyPass]: https://wiki.libsdl.org/SDL3/SDL_EndGPUCopyPass
@@ -1365,10 +1285,6 @@ func EndGPURenderPass(renderPass *GPURenderPass) {
 	sdlEndGPURenderPass(renderPass)
 }
 
-// func GenerateMipmapsForGPUTexture(command_buffer *GPUCommandBuffer, texture *GPUTexture)  {
-//	sdlGenerateMipmapsForGPUTexture(command_buffer, texture)
-// }
-
 // [GetGPUDeviceDriver] returns the name of the backend used to create this GPU context.
 //
 // [GetGPUDeviceDriver]: https://wiki.libsdl.org/SDL3/SDL_GetGPUDeviceDriver
@@ -1396,6 +1312,10 @@ func GetGPUShaderFormats(device *GPUDevice) GPUShaderFormat {
 //
 // [GetGPUDeviceProperties]: https://wiki.libsdl.org/SDL3/SDL_GetGPUDeviceProperties
 func GetGPUDeviceProperties(device *GPUDevice) PropertiesID {
+	if sdlGetGPUDeviceProperties == nil {
+		notAvailable("SDL_GetGPUDeviceProperties")
+		return 0
+	}
 	return sdlGetGPUDeviceProperties(device)
 }
 
@@ -1413,30 +1333,6 @@ func GetNumGPUDrivers() int32 {
 	return sdlGetNumGPUDrivers()
 }
 
-// func GPUSupportsProperties(props PropertiesID) bool {
-//	return sdlGPUSupportsProperties(props)
-// }
-
-// func GPUSupportsShaderFormats(format_flags GPUShaderFormat, name string) bool {
-//	return sdlGPUSupportsShaderFormats(format_flags, name)
-// }
-
-// func GPUTextureFormatTexelBlockSize(format GPUTextureFormat) uint32 {
-//	return sdlGPUTextureFormatTexelBlockSize(format)
-// }
-
-// func GPUTextureSupportsFormat(device *GPUDevice, format GPUTextureFormat, type GPUTextureType, usage GPUTextureUsageFlags) bool {
-//	return sdlGPUTextureSupportsFormat(device, format, type, usage)
-// }
-
-// func GPUTextureSupportsSampleCount(device *GPUDevice, format GPUTextureFormat, sample_count GPUSampleCount) bool {
-//	return sdlGPUTextureSupportsSampleCount(device, format, sample_count)
-// }
-
-// func InsertGPUDebugLabel(command_buffer *GPUCommandBuffer, text string)  {
-//	sdlInsertGPUDebugLabel(command_buffer, text)
-// }
-
 // [MapGPUTransferBuffer] maps a transfer buffer into application address space.
 //
 // [MapGPUTransferBuffer]: https://wiki.libsdl.org/SDL3/SDL_MapGPUTransferBuffer
@@ -1444,18 +1340,6 @@ func MapGPUTransferBuffer(device *GPUDevice, transferBuffer *GPUTransferBuffer,
 	return sdlMapGPUTransferBuffer(device, transferBuffer, cycle)
 }
 
-// func PopGPUDebugGroup(command_buffer *GPUCommandBuffer)  {
-//	sdlPopGPUDebugGroup(command_buffer)
-// }
-
-// func PushGPUComputeUniformData(command_buffer *GPUCommandBuffer, slot_index uint32, data unsafe.Pointer, length uint32)  {
-//	sdlPushGPUComputeUniformData(command_buffer, slot_index, data, length)
-// }
-
-// func PushGPUDebugGroup(command_buffer *GPUCommandBuffer, name string)  {
-//	sdlPushGPUDebugGroup(command_buffer, name)
-// }
-
 // [PushGPUFragmentUniformData] pushes data to a fragment uniform slot on the command buffer.
 //
 // [PushGPUFragmentUniformData]: https://wiki.libsdl.org/SDL3/SDL_PushGPUFragmentUniformData
@@ -1470,10 +1354,6 @@ func PushGPUVertexUniformData(commandBuffer *GPUCommandBuffer, slotIndex uint32,
 	sdlPushGPUVertexUniformData(commandBuffer, slotIndex, data, length)
 }
 
-// func QueryGPUFence(device *GPUDevice, fence *GPUFence) bool {
-//	return sdlQueryGPUFence(device, fence)
-// }
-
 // [ReleaseGPUBuffer] frees the given buffer as soon as it is safe to do so.
 //
 // [ReleaseGPUBuffer]: https://wiki.libsdl.org/SDL3/SDL_ReleaseGPUBuffer
@@ -1481,14 +1361,6 @@ func ReleaseGPUBuffer(device *GPUDevice, buffer *GPUBuffer) {
 	sdlReleaseGPUBuffer(device, buffer)
 }
 
-// func ReleaseGPUComputePipeline(device *GPUDevice, compute_pipeline *GPUComputePipeline)  {
-//	sdlReleaseGPUComputePipeline(device, compute_pipeline)
-// }
-
-// func ReleaseGPUFence(device *GPUDevice, fence *GPUFence)  {
-//	sdlReleaseGPUFence(device, fence)
-// }
-
 // [ReleaseGPUGraphicsPipeline] frees the given graphics pipeline as soon as it is safe to do so.
 //
 // [ReleaseGPUGraphicsPipeline]: https://wiki.libsdl.org/SDL3/SDL_ReleaseGPUGraphicsPipeline
@@ -1531,14 +1403,6 @@ func ReleaseWindowFromGPUDevice(device *GPUDevice, window *Window) {
 	sdlReleaseWindowFromGPUDevice(device, window)
 }
 
-// func SetGPUAllowedFramesInFlight(device *GPUDevice, allowed_frames_in_flight uint32) bool {
-//	return sdlSetGPUAllowedFramesInFlight(device, allowed_frames_in_flight)
-// }
-
-// func SetGPUBlendConstants(render_pass *GPURenderPass, blend_constants FColor)  {
-//	sdlSetGPUBlendConstants(render_pass, blend_constants)
-// }
-
 // [SetGPUBufferName] sets an arbitrary string constant to label a buffer.
 //
 // [SetGPUBufferName]: https://wiki.libsdl.org/SDL3/SDL_SetGPUBufferName
@@ -1553,10 +1417,6 @@ func SetGPUScissor(renderPass *GPURenderPass, scissor *Rect) {
 	sdlSetGPUScissor(renderPass, scissor)
 }
 
-// func SetGPUStencilReference(render_pass *GPURenderPass, reference uint8)  {
-//	sdlSetGPUStencilReference(render_pass, reference)
-// }
-
 // [SetGPUSwapchainParameters] changes the swapchain parameters for the given claimed window.
 //
 // [SetGPUSwapchainParameters]: https://wiki.libsdl.org/SDL3/SDL_SetGPUSwapchainParameters
@@ -1564,10 +1424,6 @@ func SetGPUSwapchainParameters(device *GPUDevice, window *Window, swapchainCompo
 	return sdlSetGPUSwapchainParameters(device, window, swapchainComposition, presentMode)
 }
 
-// func SetGPUTextureName(device *GPUDevice, texture *GPUTexture, text string)  {
-//	sdlSetGPUTextureName(device, texture, text)
-// }
-
 // [SetGPUViewport] sets the current viewport state on a command buffer.
 //
 // [SetGPUViewport]: https://wiki.libsdl.org/SDL3/SDL_SetGPUViewport
@@ -1582,10 +1438,6 @@ func SubmitGPUCommandBuffer(commandBuffer *GPUCommandBuffer) bool {
 	return sdlSubmitGPUCommandBuffer(commandBuffer)
 }
 
-// func SubmitGPUCommandBufferAndAcquireFence(command_buffer *GPUCommandBuffer) *GPUFence {
-//	return sdlSubmitGPUCommandBufferAndAcquireFence(command_buffer)
-// }
-
 // [UnmapGPUTransferBuffer] unmaps a previously mapped transfer buffer.
 //
 // [UnmapGPUTransferBuffer]: https://wiki.libsdl.org/SDL3/SDL_UnmapGPUTransferBuffer
@@ -1614,25 +1466,9 @@ func WaitAndAcquireGPUSwapchainTexture(commandBuffer *GPUCommandBuffer, window *
 	return sdlWaitAndAcquireGPUSwapchainTexture(commandBuffer, window, swapchainTexture, swapchainTextureWidth, swapchainTextureHeight)
 }
 
-// func WaitForGPUFences(device *GPUDevice, wait_all bool, fences **GPUFence, num_fences uint32) bool {
-//	return sdlWaitForGPUFences(device, wait_all, fences, num_fences)
-// }
-
-// func WaitForGPUIdle(device *GPUDevice) bool {
-//	return sdlWaitForGPUIdle(device)
-// }
-
-// func WaitForGPUSwapchain(device *GPUDevice, window *Window) bool {
-//	return sdlWaitForGPUSwapchain(device, window)
-// }
-
 // [WindowSupportsGPUPresentMode] determines whether a presentation mode is supported by the window.
 //
 // [WindowSupportsGPUPresentMode]: https://wiki.libsdl.org/SDL3/SDL_WindowSupportsGPUPresentMode
 func WindowSupportsGPUPresentMode(device *GPUDevice, window *Window, presentMode GPUPresentMode) bool {
 	return sdlWindowSupportsGPUPresentMode(device, window, presentMode)
 }
-
-// func WindowSupportsGPUSwapchainComposition(device *GPUDevice, window *Window, swapchain_composition GPUSwapchainComposition) bool {
-//	return sdlWindowSupportsGPUSwapchainComposition(device, window, swapchain_composition)
-// }
@@ -1,9 +0,0 @@
-package sdl
-
-// func GUIDToString(guid GUID, pszGUID string, cbGUID int32)  {
-//	sdlGUIDToString(guid, pszGUID, cbGUID)
-// }
-
-// func StringToGUID(pchGUID string) GUID {
-//	return sdlStringToGUID(pchGUID)
-// }
@@ -1,5 +1,7 @@
 package sdl
 
+import "unsafe"
+
 // [Haptic] is a structure used to identify an SDL haptic.
 //
 // [Haptic]: https://wiki.libsdl.org/SDL3/SDL_Haptic
@@ -165,142 +167,49 @@ type HapticCustom struct {
 
 // [HapticEffect] is a structure describing generic template for any haptic effect.
 //
-// [HapticEffect]: https://wiki.libsdl.org/SDL3/SDL_HapticEffect
-type HapticEffect struct {
-	Type      HapticEffectType // Effect type.
-	Constant  HapticConstant   // Constant effect.
-	Periodic  HapticPeriodic   // Periodic effect.
-	Condition HapticCondition  // Condition effect.
-	Ramp      HapticRamp       // Ramp effect.
-	Leftright HapticLeftRight  // Left/Right effect.
-	Custom    HapticCustom     // Custom effect.
-}
-
-// [HapticID] is a unique ID for a haptic device for the time it is connected to the system, and is never reused for the lifetime of the application.
+// It mirrors a C union, so the effect is accessed through the method matching its type.
+// The methods return pointers into the effect, which can be used to fill it in.
 //
-// [HapticID]: https://wiki.libsdl.org/SDL3/SDL_HapticID
-type HapticID uint32
-
-// func CloseHaptic(haptic *Haptic)  {
-//	sdlCloseHaptic(haptic)
-// }
-
-// func CreateHapticEffect(haptic *Haptic, effect *HapticEffect) int32 {
-//	return sdlCreateHapticEffect(haptic, effect)
-// }
-
-// func DestroyHapticEffect(haptic *Haptic, effect int32)  {
-//	sdlDestroyHapticEffect(haptic, effect)
-// }
-
-// func GetHapticEffectStatus(haptic *Haptic, effect int32) bool {
-//	return sdlGetHapticEffectStatus(haptic, effect)
-// }
-
-// func GetHapticFeatures(haptic *Haptic) uint32 {
-//	return sdlGetHapticFeatures(haptic)
-// }
-
-// func GetHapticFromID(instance_id HapticID) *Haptic {
-//	return sdlGetHapticFromID(instance_id)
-// }
-
-// func GetHapticID(haptic *Haptic) HapticID {
-//	return sdlGetHapticID(haptic)
-// }
-
-// func GetHapticName(haptic *Haptic) string {
-//	return sdlGetHapticName(haptic)
-// }
-
-// func GetHapticNameForID(instance_id HapticID) string {
-//	return sdlGetHapticNameForID(instance_id)
-// }
-
-// func GetHaptics(count *int32) *HapticID {
-//	return sdlGetHaptics(count)
-// }
-
-// func GetMaxHapticEffects(haptic *Haptic) int32 {
-//	return sdlGetMaxHapticEffects(haptic)
-// }
-
-// func GetMaxHapticEffectsPlaying(haptic *Haptic) int32 {
-//	return sdlGetMaxHapticEffectsPlaying(haptic)
-// }
-
-// func GetNumHapticAxes(haptic *Haptic) int32 {
-//	return sdlGetNumHapticAxes(haptic)
-// }
-
-// func HapticEffectSupported(haptic *Haptic, effect *HapticEffect) bool {
-//	return sdlHapticEffectSupported(haptic, effect)
-// }
-
-// func HapticRumbleSupported(haptic *Haptic) bool {
-//	return sdlHapticRumbleSupported(haptic)
-// }
-
-// func InitHapticRumble(haptic *Haptic) bool {
-//	return sdlInitHapticRumble(haptic)
-// }
-
-// func IsJoystickHaptic(joystick *Joystick) bool {
-//	return sdlIsJoystickHaptic(joystick)
-// }
-
-// func IsMouseHaptic() bool {
-//	return sdlIsMouseHaptic()
-// }
-
-// func OpenHaptic(instance_id HapticID) *Haptic {
-//	return sdlOpenHaptic(instance_id)
-// }
-
-// func OpenHapticFromJoystick(joystick *Joystick) *Haptic {
-//	return sdlOpenHapticFromJoystick(joystick)
-// }
-
-// func OpenHapticFromMouse() *Haptic {
-//	return sdlOpenHapticFromMouse()
-// }
-
-// func PauseHaptic(haptic *Haptic) bool {
-//	return sdlPauseHaptic(haptic)
-// }
-
-// func PlayHapticRumble(haptic *Haptic, strength float32, length uint32) bool {
-//	return sdlPlayHapticRumble(haptic, strength, length)
-// }
+// [HapticEffect]: https://wiki.libsdl.org/SDL3/SDL_HapticEffect
+type HapticEffect [72]byte
 
-// func ResumeHaptic(haptic *Haptic) bool {
-//	return sdlResumeHaptic(haptic)
-// }
+// Type returns the effect type.
+func (e *HapticEffect) Type() HapticEffectType {
+	return *(*HapticEffectType)(unsafe.Pointer(e))
+}
 
-// func RunHapticEffect(haptic *Haptic, effect int32, iterations uint32) bool {
-//	return sdlRunHapticEffect(haptic, effect, iterations)
-// }
+// Constant returns the constant effect.
+func (e *HapticEffect) Constant() *HapticConstant {
+	return (*HapticConstant)(unsafe.Pointer(e))
+}
 
-// func SetHapticAutocenter(haptic *Haptic, autocenter int32) bool {
-//	return sdlSetHapticAutocenter(haptic, autocenter)
-// }
+// Periodic returns the periodic effect.
+func (e *HapticEffect) Periodic() *HapticPeriodic {
+	return (*HapticPeriodic)(unsafe.Pointer(e))
+}
 
-// func SetHapticGain(haptic *Haptic, gain int32) bool {
-//	return sdlSetHapticGain(haptic, gain)
-// }
+// Condition returns the condition effect.
+func (e *HapticEffect) Condition() *HapticCondition {
+	return (*HapticCondition)(unsafe.Pointer(e))
+}
 
-// func StopHapticEffect(haptic *Haptic, effect int32) bool {
-//	return sdlStopHapticEffect(haptic, effect)
-// }
+// Ramp returns the ramp effect.
+func (e *HapticEffect) Ramp() *HapticRamp {
+	return (*HapticRamp)(unsafe.Pointer(e))
+}
 
-// func StopHapticEffects(haptic *Haptic) bool {
-//	return sdlStopHapticEffects(haptic)
-// }
+// LeftRight returns the left/right effect.
+func (e *HapticEffect) LeftRight() *HapticLeftRight {
+	return (*HapticLeftRight)(unsafe.Pointer(e))
+}
 
-// func StopHapticRumble(haptic *Haptic) bool {
-//	return sdlStopHapticRumble(haptic)
-// }
+// Custom returns the custom effect. Its Data must not point to Go memory, which the garbage collector doesn't know
+// to be referenced from the effect.
+func (e *HapticEffect) Custom() *HapticCustom {
+	return (*HapticCustom)(unsafe.Pointer(e))
+}
 
-// func UpdateHapticEffect(haptic *Haptic, effect int32, data *HapticEffect) bool {
-//	return sdlUpdateHapticEffect(haptic, effect, data)
-// }
+// [HapticID] is a unique ID for a haptic device for the time it is connected to the system, and is never reused for the lifetime of the application.
+//
+// [HapticID]: https://wiki.libsdl.org/SDL3/SDL_HapticID
+type HapticID uint32
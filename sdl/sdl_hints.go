@@ -289,6 +289,8 @@ const (
 // [HintCallback]: https://wiki.libsdl.org/SDL3/SDL_HintCallback
 type HintCallback uintptr
 
+// NewHintCallback converts the Go function to a C function pointer, which is never freed.
+// Prefer [AddHintCallbackFunc] when callbacks are added repeatedly.
 func NewHintCallback(callback func(userdata unsafe.Pointer, name, oldValue, newValue string)) HintCallback {
 	cb := purego.NewCallback(func(userdata unsafe.Pointer, name, oldValue, newValue *byte) uintptr {
 		callback(userdata, convert.ToString(name), convert.ToString(oldValue), convert.ToString(newValue))
@@ -298,6 +300,33 @@ func NewHintCallback(callback func(userdata unsafe.Pointer, name, oldValue, newV
 	return HintCallback(cb)
 }
 
+var hintTrampoline trampoline
+
+func hintCallbackFunc() HintCallback {
+	return HintCallback(hintTrampoline.get(func(userdata uintptr, name, oldValue, newValue *byte) uintptr {
+		if callback, ok := lookupCallback(userdata).(func(string, string, string)); ok {
+			callback(convert.ToString(name), convert.ToString(oldValue), convert.ToString(newValue))
+		}
+		return 0
+	}))
+}
+
+// AddHintCallbackFunc is like [AddHintCallback], but takes a Go function. Release the returned handle to remove it.
+//
+// The callback is called immediately with the current value of the hint.
+func AddHintCallbackFunc(name string, callback func(name, oldValue, newValue string)) (*CallbackHandle, bool) {
+	var h *CallbackHandle
+	h = newCallbackHandle(callback, func() {
+		sdlRemoveHintCallback(name, hintCallbackFunc(), h.pointer())
+	})
+	if !sdlAddHintCallback(name, hintCallbackFunc(), h.pointer()) {
+		h.release = nil
+		h.Release()
+		return nil, false
+	}
+	return h, true
+}
+
 // [SetHint] sets a hint with normal priority.
 //
 // [SetHint]: https://wiki.libsdl.org/SDL3/SDL_SetHint
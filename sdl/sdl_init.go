@@ -1,5 +1,7 @@
 package sdl
 
+import "unsafe"
+
 // [AppResult] defines the return values for optional main callbacks.
 //
 // [AppResult]: https://wiki.libsdl.org/SDL3/SDL_AppResult
@@ -11,6 +13,11 @@ const (
 	AppFailure                   // Value that requests termination with error from the main callbacks.
 )
 
+// [MainThreadCallback] is a callback run on the main thread. Use [Do] or [DoAsync] to run Go functions there.
+//
+// [MainThreadCallback]: https://wiki.libsdl.org/SDL3/SDL_MainThreadCallback
+type MainThreadCallback uintptr
+
 // [InitFlags] defines the initialization flags for [Init] and/or [InitSubSystem].
 //
 // [InitFlags]: https://wiki.libsdl.org/SDL3/SDL_InitFlags
@@ -73,18 +80,9 @@ func IsMainThread() bool {
 	return sdlIsMainThread()
 }
 
-// func RunOnMainThread(callback MainThreadCallback, userdata unsafe.Pointer, wait_complete bool) bool {
-//	return sdlRunOnMainThread(callback, userdata, wait_complete)
-// }
-
-// func SetAppMetadata(appname string, appversion string, appidentifier string) bool {
-//	return sdlSetAppMetadata(appname, appversion, appidentifier)
-// }
-
-// func SetAppMetadataProperty(name string, value string) bool {
-//	return sdlSetAppMetadataProperty(name, value)
-// }
-
-// func WasInit(flags InitFlags) InitFlags {
-//	return sdlWasInit(flags)
-// }
+// [RunOnMainThread] calls a function on the main thread during event processing.
+//
+// [RunOnMainThread]: https://wiki.libsdl.org/SDL3/SDL_RunOnMainThread
+func RunOnMainThread(callback MainThreadCallback, userdata unsafe.Pointer, waitComplete bool) bool {
+	return sdlRunOnMainThread(callback, userdata, waitComplete)
+}
@@ -41,15 +41,18 @@ const (
 
 // [IOStreamInterface] defines the function pointers that drive an [IOStream].
 //
+// The fields are C function pointers, e.g. created with purego.NewCallback.
+// Version must be set to the size of the struct. See [IOFromReader] for streams implemented in Go.
+//
 // [IOStreamInterface]: https://wiki.libsdl.org/SDL3/SDL_IOStreamInterface
 type IOStreamInterface struct {
 	Version uint32
-	Size    *func(userdata uintptr) int64
-	Seek    *func(userdata uintptr, offset int64, whence IOWhence) int64
-	Read    *func(userdata, ptr, size uintptr, status *IOStatus) uintptr
-	Write   *func(userdata, ptr, size uintptr, status *IOStatus) uintptr
-	Flush   *func(userdata uintptr, status *IOStatus) bool
-	Close   *func(userdata uintptr) bool
+	Size    uintptr // func(userdata unsafe.Pointer) int64
+	Seek    uintptr // func(userdata unsafe.Pointer, offset int64, whence IOWhence) int64
+	Read    uintptr // func(userdata, ptr unsafe.Pointer, size uint64, status *IOStatus) uint64
+	Write   uintptr // func(userdata, ptr unsafe.Pointer, size uint64, status *IOStatus) uint64
+	Flush   uintptr // func(userdata unsafe.Pointer, status *IOStatus) bool
+	Close   uintptr // func(userdata unsafe.Pointer) bool
 }
 
 // [IOStream] is a structure specifying the read/write operation structures.
@@ -71,25 +74,40 @@ func CloseIO(context *IOStream) bool {
 	return sdlCloseIO(context)
 }
 
-// func FlushIO(context *IOStream) bool {
-//	return sdlFlushIO(context)
-// }
+// [FlushIO] flushes any buffered data in the stream.
+//
+// [FlushIO]: https://wiki.libsdl.org/SDL3/SDL_FlushIO
+func FlushIO(context *IOStream) bool {
+	return sdlFlushIO(context)
+}
 
-// func GetIOProperties(context *IOStream) PropertiesID {
-//	return sdlGetIOProperties(context)
-// }
+// [GetIOProperties] gets the properties associated with an [IOStream].
+//
+// [GetIOProperties]: https://wiki.libsdl.org/SDL3/SDL_GetIOProperties
+func GetIOProperties(context *IOStream) PropertiesID {
+	return sdlGetIOProperties(context)
+}
 
-// func GetIOSize(context *IOStream) int64 {
-//	return sdlGetIOSize(context)
-// }
+// [GetIOSize] gets the size of the data stream in an [IOStream], or a negative error code.
+//
+// [GetIOSize]: https://wiki.libsdl.org/SDL3/SDL_GetIOSize
+func GetIOSize(context *IOStream) int64 {
+	return sdlGetIOSize(context)
+}
 
-// func GetIOStatus(context *IOStream) IOStatus {
-//	return sdlGetIOStatus(context)
-// }
+// [GetIOStatus] queries the stream status of an [IOStream].
+//
+// [GetIOStatus]: https://wiki.libsdl.org/SDL3/SDL_GetIOStatus
+func GetIOStatus(context *IOStream) IOStatus {
+	return sdlGetIOStatus(context)
+}
 
-// func IOFromDynamicMem() *IOStream {
-//	return sdlIOFromDynamicMem()
-// }
+// [IOFromDynamicMem] uses this function to create an [IOStream] that is backed by dynamically allocated memory.
+//
+// [IOFromDynamicMem]: https://wiki.libsdl.org/SDL3/SDL_IOFromDynamicMem
+func IOFromDynamicMem() *IOStream {
+	return sdlIOFromDynamicMem()
+}
 
 // [IOFromFile] returns an [IOStream] for the named file. The mode can be "r" for read-only.
 //
@@ -105,14 +123,6 @@ func IOFromMem(mem []byte) *IOStream {
 	return sdlIOFromMem(mem, len(mem))
 }
 
-// func IOprintf(context *IOStream, fmt string) uint64 {
-//	return sdlIOprintf(context, fmt)
-// }
-
-// func IOvprintf(context *IOStream, fmt string, ap va_list) uint64 {
-//	return sdlIOvprintf(context, fmt, ap)
-// }
-
 // [LoadFile] loads all the data from a file path.
 //
 // [LoadFile]: https://wiki.libsdl.org/SDL3/SDL_LoadFile
@@ -120,146 +130,254 @@ func LoadFile(file string, dataSize *uint64) unsafe.Pointer {
 	return sdlLoadFile(file, dataSize)
 }
 
-// func LoadFile_IO(src *IOStream, datasize *uint64, closeio bool) unsafe.Pointer {
-//	return sdlLoadFile_IO(src, datasize, closeio)
-// }
+// [LoadFileIO] loads all the data from an [IOStream]. The data must be freed with [Free].
+//
+// [LoadFileIO]: https://wiki.libsdl.org/SDL3/SDL_LoadFile_IO
+func LoadFileIO(src *IOStream, dataSize *uint64, closeio bool) unsafe.Pointer {
+	return sdlLoadFileIO(src, dataSize, closeio)
+}
 
-// func OpenIO(iface *IOStreamInterface, userdata unsafe.Pointer) *IOStream {
-//	return sdlOpenIO(iface, userdata)
-// }
+// [OpenIO] creates a custom [IOStream] or returns nil on failure. The interface is copied.
+//
+// [OpenIO]: https://wiki.libsdl.org/SDL3/SDL_OpenIO
+func OpenIO(iface *IOStreamInterface, userdata unsafe.Pointer) *IOStream {
+	return sdlOpenIO(iface, userdata)
+}
 
-// func ReadIO(context *IOStream, ptr unsafe.Pointer, size uint64) uint64 {
-//	return sdlReadIO(context, ptr, size)
-// }
+// [ReadIO] reads from a data source and returns the number of bytes read.
+//
+// [ReadIO]: https://wiki.libsdl.org/SDL3/SDL_ReadIO
+func ReadIO(context *IOStream, ptr unsafe.Pointer, size uint64) uint64 {
+	return sdlReadIO(context, ptr, size)
+}
 
-// func ReadS16BE(src *IOStream, value *int16) bool {
-//	return sdlReadS16BE(src, value)
-// }
+// [ReadS16BE] reads 16 bits of big-endian data from an [IOStream] and returns them in native format.
+//
+// [ReadS16BE]: https://wiki.libsdl.org/SDL3/SDL_ReadS16BE
+func ReadS16BE(src *IOStream, value *int16) bool {
+	return sdlReadS16BE(src, value)
+}
 
-// func ReadS16LE(src *IOStream, value *int16) bool {
-//	return sdlReadS16LE(src, value)
-// }
+// [ReadS16LE] reads 16 bits of little-endian data from an [IOStream] and returns them in native format.
+//
+// [ReadS16LE]: https://wiki.libsdl.org/SDL3/SDL_ReadS16LE
+func ReadS16LE(src *IOStream, value *int16) bool {
+	return sdlReadS16LE(src, value)
+}
 
-// func ReadS32BE(src *IOStream, value *int32) bool {
-//	return sdlReadS32BE(src, value)
-// }
+// [ReadS32BE] reads 32 bits of big-endian data from an [IOStream] and returns them in native format.
+//
+// [ReadS32BE]: https://wiki.libsdl.org/SDL3/SDL_ReadS32BE
+func ReadS32BE(src *IOStream, value *int32) bool {
+	return sdlReadS32BE(src, value)
+}
 
-// func ReadS32LE(src *IOStream, value *int32) bool {
-//	return sdlReadS32LE(src, value)
-// }
+// [ReadS32LE] reads 32 bits of little-endian data from an [IOStream] and returns them in native format.
+//
+// [ReadS32LE]: https://wiki.libsdl.org/SDL3/SDL_ReadS32LE
+func ReadS32LE(src *IOStream, value *int32) bool {
+	return sdlReadS32LE(src, value)
+}
 
-// func ReadS64BE(src *IOStream, value *int64) bool {
-//	return sdlReadS64BE(src, value)
-// }
+// [ReadS64BE] reads 64 bits of big-endian data from an [IOStream] and returns them in native format.
+//
+// [ReadS64BE]: https://wiki.libsdl.org/SDL3/SDL_ReadS64BE
+func ReadS64BE(src *IOStream, value *int64) bool {
+	return sdlReadS64BE(src, value)
+}
 
-// func ReadS64LE(src *IOStream, value *int64) bool {
-//	return sdlReadS64LE(src, value)
-// }
+// [ReadS64LE] reads 64 bits of little-endian data from an [IOStream] and returns them in native format.
+//
+// [ReadS64LE]: https://wiki.libsdl.org/SDL3/SDL_ReadS64LE
+func ReadS64LE(src *IOStream, value *int64) bool {
+	return sdlReadS64LE(src, value)
+}
 
-// func ReadS8(src *IOStream, value *int8) bool {
-//	return sdlReadS8(src, value)
-// }
+// [ReadS8] reads a signed byte from an [IOStream].
+//
+// [ReadS8]: https://wiki.libsdl.org/SDL3/SDL_ReadS8
+func ReadS8(src *IOStream, value *int8) bool {
+	return sdlReadS8(src, value)
+}
 
-// func ReadU16BE(src *IOStream, value *uint16) bool {
-//	return sdlReadU16BE(src, value)
-// }
+// [ReadU16BE] reads 16 bits of big-endian data from an [IOStream] and returns them in native format.
+//
+// [ReadU16BE]: https://wiki.libsdl.org/SDL3/SDL_ReadU16BE
+func ReadU16BE(src *IOStream, value *uint16) bool {
+	return sdlReadU16BE(src, value)
+}
 
-// func ReadU16LE(src *IOStream, value *uint16) bool {
-//	return sdlReadU16LE(src, value)
-// }
+// [ReadU16LE] reads 16 bits of little-endian data from an [IOStream] and returns them in native format.
+//
+// [ReadU16LE]: https://wiki.libsdl.org/SDL3/SDL_ReadU16LE
+func ReadU16LE(src *IOStream, value *uint16) bool {
+	return sdlReadU16LE(src, value)
+}
 
-// func ReadU32BE(src *IOStream, value *uint32) bool {
-//	return sdlReadU32BE(src, value)
-// }
+// [ReadU32BE] reads 32 bits of big-endian data from an [IOStream] and returns them in native format.
+//
+// [ReadU32BE]: https://wiki.libsdl.org/SDL3/SDL_ReadU32BE
+func ReadU32BE(src *IOStream, value *uint32) bool {
+	return sdlReadU32BE(src, value)
+}
 
-// func ReadU32LE(src *IOStream, value *uint32) bool {
-//	return sdlReadU32LE(src, value)
-// }
+// [ReadU32LE] reads 32 bits of little-endian data from an [IOStream] and returns them in native format.
+//
+// [ReadU32LE]: https://wiki.libsdl.org/SDL3/SDL_ReadU32LE
+func ReadU32LE(src *IOStream, value *uint32) bool {
+	return sdlReadU32LE(src, value)
+}
 
-// func ReadU64BE(src *IOStream, value *uint64) bool {
-//	return sdlReadU64BE(src, value)
-// }
+// [ReadU64BE] reads 64 bits of big-endian data from an [IOStream] and returns them in native format.
+//
+// [ReadU64BE]: https://wiki.libsdl.org/SDL3/SDL_ReadU64BE
+func ReadU64BE(src *IOStream, value *uint64) bool {
+	return sdlReadU64BE(src, value)
+}
 
-// func ReadU64LE(src *IOStream, value *uint64) bool {
-//	return sdlReadU64LE(src, value)
-// }
+// [ReadU64LE] reads 64 bits of little-endian data from an [IOStream] and returns them in native format.
+//
+// [ReadU64LE]: https://wiki.libsdl.org/SDL3/SDL_ReadU64LE
+func ReadU64LE(src *IOStream, value *uint64) bool {
+	return sdlReadU64LE(src, value)
+}
 
-// func ReadU8(src *IOStream, value *uint8) bool {
-//	return sdlReadU8(src, value)
-// }
+// [ReadU8] reads an unsigned byte from an [IOStream].
+//
+// [ReadU8]: https://wiki.libsdl.org/SDL3/SDL_ReadU8
+func ReadU8(src *IOStream, value *uint8) bool {
+	return sdlReadU8(src, value)
+}
 
-// func SaveFile(file string, data unsafe.Pointer, datasize uint64) bool {
-//	return sdlSaveFile(file, data, datasize)
-// }
+// [SaveFile] saves all the data into a file path.
+//
+// [SaveFile]: https://wiki.libsdl.org/SDL3/SDL_SaveFile
+func SaveFile(file string, data unsafe.Pointer, dataSize uint64) bool {
+	return sdlSaveFile(file, data, dataSize)
+}
 
-// func SaveFile_IO(src *IOStream, data unsafe.Pointer, datasize uint64, closeio bool) bool {
-//	return sdlSaveFile_IO(src, data, datasize, closeio)
-// }
+// [SaveFileIO] saves all the data into an [IOStream].
+//
+// [SaveFileIO]: https://wiki.libsdl.org/SDL3/SDL_SaveFile_IO
+func SaveFileIO(src *IOStream, data unsafe.Pointer, dataSize uint64, closeio bool) bool {
+	return sdlSaveFileIO(src, data, dataSize, closeio)
+}
 
-// func SeekIO(context *IOStream, offset int64, whence IOWhence) int64 {
-//	return sdlSeekIO(context, offset, whence)
-// }
+// [SeekIO] seeks within an [IOStream] data stream and returns the final offset or -1 on failure.
+//
+// [SeekIO]: https://wiki.libsdl.org/SDL3/SDL_SeekIO
+func SeekIO(context *IOStream, offset int64, whence IOWhence) int64 {
+	return sdlSeekIO(context, offset, whence)
+}
 
-// func TellIO(context *IOStream) int64 {
-//	return sdlTellIO(context)
-// }
+// [TellIO] determines the current read/write offset in an [IOStream] data stream.
+//
+// [TellIO]: https://wiki.libsdl.org/SDL3/SDL_TellIO
+func TellIO(context *IOStream) int64 {
+	return sdlTellIO(context)
+}
 
-// func WriteIO(context *IOStream, ptr unsafe.Pointer, size uint64) uint64 {
-//	return sdlWriteIO(context, ptr, size)
-// }
+// [WriteIO] writes to an [IOStream] data stream and returns the number of bytes written.
+//
+// [WriteIO]: https://wiki.libsdl.org/SDL3/SDL_WriteIO
+func WriteIO(context *IOStream, ptr unsafe.Pointer, size uint64) uint64 {
+	return sdlWriteIO(context, ptr, size)
+}
 
-// func WriteS16BE(dst *IOStream, value int16) bool {
-//	return sdlWriteS16BE(dst, value)
-// }
+// [WriteS16BE] writes 16 bits in native format to an [IOStream] as big-endian data.
+//
+// [WriteS16BE]: https://wiki.libsdl.org/SDL3/SDL_WriteS16BE
+func WriteS16BE(dst *IOStream, value int16) bool {
+	return sdlWriteS16BE(dst, value)
+}
 
-// func WriteS16LE(dst *IOStream, value int16) bool {
-//	return sdlWriteS16LE(dst, value)
-// }
+// [WriteS16LE] writes 16 bits in native format to an [IOStream] as little-endian data.
+//
+// [WriteS16LE]: https://wiki.libsdl.org/SDL3/SDL_WriteS16LE
+func WriteS16LE(dst *IOStream, value int16) bool {
+	return sdlWriteS16LE(dst, value)
+}
 
-// func WriteS32BE(dst *IOStream, value int32) bool {
-//	return sdlWriteS32BE(dst, value)
-// }
+// [WriteS32BE] writes 32 bits in native format to an [IOStream] as big-endian data.
+//
+// [WriteS32BE]: https://wiki.libsdl.org/SDL3/SDL_WriteS32BE
+func WriteS32BE(dst *IOStream, value int32) bool {
+	return sdlWriteS32BE(dst, value)
+}
 
-// func WriteS32LE(dst *IOStream, value int32) bool {
-//	return sdlWriteS32LE(dst, value)
-// }
+// [WriteS32LE] writes 32 bits in native format to an [IOStream] as little-endian data.
+//
+// [WriteS32LE]: https://wiki.libsdl.org/SDL3/SDL_WriteS32LE
+func WriteS32LE(dst *IOStream, value int32) bool {
+	return sdlWriteS32LE(dst, value)
+}
 
-// func WriteS64BE(dst *IOStream, value int64) bool {
-//	return sdlWriteS64BE(dst, value)
-// }
+// [WriteS64BE] writes 64 bits in native format to an [IOStream] as big-endian data.
+//
+// [WriteS64BE]: https://wiki.libsdl.org/SDL3/SDL_WriteS64BE
+func WriteS64BE(dst *IOStream, value int64) bool {
+	return sdlWriteS64BE(dst, value)
+}
 
-// func WriteS64LE(dst *IOStream, value int64) bool {
-//	return sdlWriteS64LE(dst, value)
-// }
+// [WriteS64LE] writes 64 bits in native format to an [IOStream] as little-endian data.
+//
+// [WriteS64LE]: https://wiki.libsdl.org/SDL3/SDL_WriteS64LE
+func WriteS64LE(dst *IOStream, value int64) bool {
+	return sdlWriteS64LE(dst, value)
+}
 
-// func WriteS8(dst *IOStream, value int8) bool {
-//	return sdlWriteS8(dst, value)
-// }
+// [WriteS8] writes a signed byte to an [IOStream].
+//
+// [WriteS8]: https://wiki.libsdl.org/SDL3/SDL_WriteS8
+func WriteS8(dst *IOStream, value int8) bool {
+	return sdlWriteS8(dst, value)
+}
 
-// func WriteU16BE(dst *IOStream, value uint16) bool {
-//	return sdlWriteU16BE(dst, value)
-// }
+// [WriteU16BE] writes 16 bits in native format to an [IOStream] as big-endian data.
+//
+// [WriteU16BE]: https://wiki.libsdl.org/SDL3/SDL_WriteU16BE
+func WriteU16BE(dst *IOStream, value uint16) bool {
+	return sdlWriteU16BE(dst, value)
+}
 
-// func WriteU16LE(dst *IOStream, value uint16) bool {
-//	return sdlWriteU16LE(dst, value)
-// }
+// [WriteU16LE] writes 16 bits in native format to an [IOStream] as little-endian data.
+//
+// [WriteU16LE]: https://wiki.libsdl.org/SDL3/SDL_WriteU16LE
+func WriteU16LE(dst *IOStream, value uint16) bool {
+	return sdlWriteU16LE(dst, value)
+}
 
-// func WriteU32BE(dst *IOStream, value uint32) bool {
-//	return sdlWriteU32BE(dst, value)
-// }
+// [WriteU32BE] writes 32 bits in native format to an [IOStream] as big-endian data.
+//
+// [WriteU32BE]: https://wiki.libsdl.org/SDL3/SDL_WriteU32BE
+func WriteU32BE(dst *IOStream, value uint32) bool {
+	return sdlWriteU32BE(dst, value)
+}
 
-// func WriteU32LE(dst *IOStream, value uint32) bool {
-//	return sdlWriteU32LE(dst, value)
-// }
+// [WriteU32LE] writes 32 bits in native format to an [IOStream] as little-endian data.
+//
+// [WriteU32LE]: https://wiki.libsdl.org/SDL3/SDL_WriteU32LE
+func WriteU32LE(dst *IOStream, value uint32) bool {
+	return sdlWriteU32LE(dst, value)
+}
 
-// func WriteU64BE(dst *IOStream, value uint64) bool {
-//	return sdlWriteU64BE(dst, value)
-// }
+// [WriteU64BE] writes 64 bits in native format to an [IOStream] as big-endian data.
+//
+// [WriteU64BE]: https://wiki.libsdl.org/SDL3/SDL_WriteU64BE
+func WriteU64BE(dst *IOStream, value uint64) bool {
+	return sdlWriteU64BE(dst, value)
+}
 
-// func WriteU64LE(dst *IOStream, value uint64) bool {
-//	return sdlWriteU64LE(dst, value)
-// }
+// [WriteU64LE] writes 64 bits in native format to an [IOStream] as little-endian data.
+//
+// [WriteU64LE]: https://wiki.libsdl.org/SDL3/SDL_WriteU64LE
+func WriteU64LE(dst *IOStream, value uint64) bool {
+	return sdlWriteU64LE(dst, value)
+}
 
-// func WriteU8(dst *IOStream, value uint8) bool {
-//	return sdlWriteU8(dst, value)
-// }
+// [WriteU8] writes a unsigned byte to an [IOStream].
+//
+// [WriteU8]: https://wiki.libsdl.org/SDL3/SDL_WriteU8
+func WriteU8(dst *IOStream, value uint8) bool {
+	return sdlWriteU8(dst, value)
+}
@@ -102,10 +102,6 @@ func GetJoystickPlayerIndexForID(instanceId JoystickID) int32 {
 	return sdlGetJoystickPlayerIndexForID(instanceId)
 }
 
-// func GetJoystickGUIDForID(instanceId JoystickID) GUID {
-// 	return sdlGetJoystickGUIDForID(instanceId)
-// }
-
 // [GetJoystickVendorForID] gets the USB vendor ID of a joystick, if available.
 //
 // [GetJoystickVendorForID]: https://wiki.libsdl.org/SDL3/SDL_GetJoystickVendorForID
@@ -203,42 +199,6 @@ type VirtualJoystickDesc struct {
 	Cleanup           *func(userdata uintptr)                                                      // Cleans up the userdata when the joystick is detached.
 }
 
-// func AttachVirtualJoystick(desc *VirtualJoystickDesc) JoystickID {
-// 	return sdlAttachVirtualJoystick(desc)
-// }
-
-// func DetachVirtualJoystick(instance_id JoystickID) bool {
-// 	return sdlDetachVirtualJoystick(instance_id)
-// }
-
-// func IsJoystickVirtual(instance_id JoystickID) bool {
-// 	return sdlIsJoystickVirtual(instance_id)
-// }
-
-// func SetJoystickVirtualAxis(joystick *Joystick, axis int32, value int16) bool {
-// 	return sdlSetJoystickVirtualAxis(joystick, axis, value)
-// }
-
-// func SetJoystickVirtualBall(joystick *Joystick, ball int32, xrel int16, yrel int16) bool {
-// 	return sdlSetJoystickVirtualBall(joystick, ball, xrel, yrel)
-// }
-
-// func SetJoystickVirtualButton(joystick *Joystick, button int32, down bool) bool {
-// 	return sdlSetJoystickVirtualButton(joystick, button, down)
-// }
-
-// func SetJoystickVirtualHat(joystick *Joystick, hat int32, value uint8) bool {
-// 	return sdlSetJoystickVirtualHat(joystick, hat, value)
-// }
-
-// func SetJoystickVirtualTouchpad(joystick *Joystick, touchpad int32, finger int32, down bool, x float32, y float32, pressure float32) bool {
-// 	return sdlSetJoystickVirtualTouchpad(joystick, touchpad, finger, down, x, y, pressure)
-// }
-
-// func SendJoystickVirtualSensorData(joystick *Joystick, sensorType SensorType, sensor_timestamp uint64, data *float32, num_values int32) bool {
-// 	return sdlSendJoystickVirtualSensorData(joystick, sensorType, sensor_timestamp, data, num_values)
-// }
-
 // [GetJoystickProperties] gets the properties associated with a joystick.
 //
 // [GetJoystickProperties]: https://wiki.libsdl.org/SDL3/SDL_GetJoystickProperties
@@ -282,10 +242,6 @@ func SetJoystickPlayerIndex(joystick *Joystick, playerIndex int32) bool {
 	return sdlSetJoystickPlayerIndex(joystick, playerIndex)
 }
 
-// func GetJoystickGUID(joystick *Joystick) GUID {
-// 	return sdlGetJoystickGUID(joystick)
-// }
-
 // [GetJoystickVendor] gets the USB vendor ID of an opened joystick, if available.
 //
 // [GetJoystickVendor]: https://wiki.libsdl.org/SDL3/SDL_GetJoystickVendor
@@ -328,10 +284,6 @@ func GetJoystickType(joystick *Joystick) JoystickType {
 	return sdlGetJoystickType(joystick)
 }
 
-// func GetJoystickGUIDInfo(guid GUID, vendor *uint16, product *uint16, version *uint16, crc16 *uint16) {
-// 	sdlGetJoystickGUIDInfo(guid, vendor, product, version, crc16)
-// }
-
 // [JoystickConnected] gets the status of a specified joystick.
 //
 // [JoystickConnected]: https://wiki.libsdl.org/SDL3/SDL_JoystickConnected
@@ -2,6 +2,8 @@ package sdl
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 	"unsafe"
 
 	"github.com/ebitengine/purego"
@@ -53,8 +55,27 @@ const (
 	LogCategoryCustom
 )
 
+var logCategoryNames = [...]string{"application", "error", "assert", "system", "audio", "video", "render", "input", "test", "gpu"}
+
+// String returns the name of the category in lower case, e.g. "video". Custom categories are named
+// "custom", "custom+1" and so on.
+func (category LogCategory) String() string {
+	switch {
+	case int(category) < len(logCategoryNames):
+		return logCategoryNames[category]
+	case category == LogCategoryCustom:
+		return "custom"
+	case category > LogCategoryCustom:
+		return "custom+" + strconv.Itoa(int(category-LogCategoryCustom))
+	default:
+		return "reserved" + strconv.Itoa(int(category-LogCategoryReserved2+2))
+	}
+}
+
 type LogOutputFunction uintptr
 
+// NewLogOutputFunctionCallback converts the Go function to a C function pointer, which is never freed.
+// Prefer [SetLogOutputFunc] when the function is replaced repeatedly.
 func NewLogOutputFunctionCallback(callback func(userdata unsafe.Pointer, category LogCategory, priority LogPriority, message string)) LogOutputFunction {
 	cb := purego.NewCallback(func(userdata unsafe.Pointer, category int32, priority LogPriority, message *byte) uintptr {
 		callback(userdata, LogCategory(category), priority, convert.ToString(message))
@@ -64,66 +85,121 @@ func NewLogOutputFunctionCallback(callback func(userdata unsafe.Pointer, categor
 	return LogOutputFunction(cb)
 }
 
-// func GetDefaultLogOutputFunction() LogOutputFunction {
-//	return sdlGetDefaultLogOutputFunction()
-// }
+var logOutputTrampoline trampoline
+
+func logOutputFunctionFunc() LogOutputFunction {
+	return LogOutputFunction(logOutputTrampoline.get(func(userdata uintptr, category int32, priority LogPriority, message *byte) uintptr {
+		if callback, ok := lookupCallback(userdata).(func(LogCategory, LogPriority, string)); ok {
+			callback(LogCategory(category), priority, convert.ToString(message))
+		}
+		return 0
+	}))
+}
+
+// SetLogOutputFunc is like [SetLogOutputFunction], but takes a Go function.
+// Releasing the returned handle restores the previous output function, unless it has been replaced in the meantime.
+func SetLogOutputFunc(callback func(category LogCategory, priority LogPriority, message string)) *CallbackHandle {
+	var prevCallback LogOutputFunction
+	var prevUserdata unsafe.Pointer
+	sdlGetLogOutputFunction(&prevCallback, &prevUserdata)
+
+	var h *CallbackHandle
+	h = newCallbackHandle(callback, func() {
+		var current LogOutputFunction
+		var userdata unsafe.Pointer
+		sdlGetLogOutputFunction(&current, &userdata)
+		if userdata == h.pointer() {
+			sdlSetLogOutputFunction(prevCallback, prevUserdata)
+		}
+	})
+	sdlSetLogOutputFunction(logOutputFunctionFunc(), h.pointer())
+	return h
+}
+
+// [GetDefaultLogOutputFunction] gets the default log output function, e.g. to restore it or to call it from
+// a custom output function with [purego.SyscallN].
+//
+// [GetDefaultLogOutputFunction]: https://wiki.libsdl.org/SDL3/SDL_GetDefaultLogOutputFunction
+func GetDefaultLogOutputFunction() LogOutputFunction {
+	return sdlGetDefaultLogOutputFunction()
+}
 
-// func GetLogOutputFunction(callback *LogOutputFunction, userdata *unsafe.Pointer)  {
-//	sdlGetLogOutputFunction(callback, userdata)
-// }
+// [GetLogOutputFunction] gets the current log output function.
+//
+// [GetLogOutputFunction]: https://wiki.libsdl.org/SDL3/SDL_GetLogOutputFunction
+func GetLogOutputFunction(callback *LogOutputFunction, userdata *unsafe.Pointer) {
+	sdlGetLogOutputFunction(callback, userdata)
+}
 
-// func GetLogPriority(category int32) LogPriority {
-//	return sdlGetLogPriority(category)
-// }
+// [GetLogPriority] gets the priority of a particular log category.
+//
+// [GetLogPriority]: https://wiki.libsdl.org/SDL3/SDL_GetLogPriority
+func GetLogPriority(category LogCategory) LogPriority {
+	return sdlGetLogPriority(category)
+}
 
 // [Log] logs a message with [LOG_CATEGORY_APPLICATION] and [LOG_PRIORITY_INFO].
 //
 // [Log]: https://wiki.libsdl.org/SDL3/SDL_Log
 func Log(format string, a ...any) {
-	sdlLog(fmt.Sprintf(format, a...))
+	sdlLog(logMessage(format, a))
 }
 
-// func LogCritical(category int32, fmt string)  {
-//	sdlLogCritical(category, fmt)
-// }
+// [LogCritical] logs a message with [LOG_PRIORITY_CRITICAL].
+//
+// [LogCritical]: https://wiki.libsdl.org/SDL3/SDL_LogCritical
+func LogCritical(category LogCategory, format string, a ...any) {
+	sdlLogCritical(category, logMessage(format, a))
+}
 
-// func LogDebug(category int32, fmt string)  {
-//	sdlLogDebug(category, fmt)
-// }
+// [LogDebug] logs a message with [LOG_PRIORITY_DEBUG].
+//
+// [LogDebug]: https://wiki.libsdl.org/SDL3/SDL_LogDebug
+func LogDebug(category LogCategory, format string, a ...any) {
+	sdlLogDebug(category, logMessage(format, a))
+}
 
 // [LogError] logs a message with [LOG_PRIORITY_ERROR].
 //
 // [LogError]: https://wiki.libsdl.org/SDL3/SDL_LogError
 func LogError(category LogCategory, format string, a ...any) {
-	sdlLogError(category, fmt.Sprintf(format, a...))
+	sdlLogError(category, logMessage(format, a))
 }
 
-// func LogInfo(category int32, fmt string)  {
-//	sdlLogInfo(category, fmt)
-// }
+// [LogInfo] logs a message with [LOG_PRIORITY_INFO].
+//
+// [LogInfo]: https://wiki.libsdl.org/SDL3/SDL_LogInfo
+func LogInfo(category LogCategory, format string, a ...any) {
+	sdlLogInfo(category, logMessage(format, a))
+}
 
 // [LogMessage] logs a message with the specified category and priority.
 //
 // [LogMessage]: https://wiki.libsdl.org/SDL3/SDL_LogMessage
 func LogMessage(category LogCategory, priority LogPriority, format string, a ...any) {
-	sdlLogMessage(category, priority, fmt.Sprintf(format, a...))
+	sdlLogMessage(category, priority, logMessage(format, a))
 }
 
-// func LogMessageV(category int32, priority LogPriority, fmt string, ap va_list)  {
-//	sdlLogMessageV(category, priority, fmt, ap)
-// }
-
-// func LogTrace(category int32, fmt string)  {
-//	sdlLogTrace(category, fmt)
-// }
+// [LogTrace] logs a message with [LOG_PRIORITY_TRACE].
+//
+// [LogTrace]: https://wiki.libsdl.org/SDL3/SDL_LogTrace
+func LogTrace(category LogCategory, format string, a ...any) {
+	sdlLogTrace(category, logMessage(format, a))
+}
 
-// func LogVerbose(category int32, fmt string)  {
-//	sdlLogVerbose(category, fmt)
-// }
+// [LogVerbose] logs a message with [LOG_PRIORITY_VERBOSE].
+//
+// [LogVerbose]: https://wiki.libsdl.org/SDL3/SDL_LogVerbose
+func LogVerbose(category LogCategory, format string, a ...any) {
+	sdlLogVerbose(category, logMessage(format, a))
+}
 
-// func LogWarn(category int32, fmt string)  {
-//	sdlLogWarn(category, fmt)
-// }
+// [LogWarn] logs a message with [LOG_PRIORITY_WARN].
+//
+// [LogWarn]: https://wiki.libsdl.org/SDL3/SDL_LogWarn
+func LogWarn(category LogCategory, format string, a ...any) {
+	sdlLogWarn(category, logMessage(format, a))
+}
 
 // [ResetLogPriorities] resets all priorities to default.
 //
@@ -153,6 +229,15 @@ func SetLogPriority(category int32, priority LogPriority) {
 	sdlSetLogPriority(category, priority)
 }
 
-// func SetLogPriorityPrefix(priority LogPriority, prefix string) bool {
-//	return sdlSetLogPriorityPrefix(priority, prefix)
-// }
+// [SetLogPriorityPrefix] sets the text prepended to log messages of a given priority, e.g. "WARNING: ".
+// An empty prefix removes it.
+//
+// [SetLogPriorityPrefix]: https://wiki.libsdl.org/SDL3/SDL_SetLogPriorityPrefix
+func SetLogPriorityPrefix(priority LogPriority, prefix string) bool {
+	return sdlSetLogPriorityPrefix(priority, prefix)
+}
+
+// logMessage formats a message for the log functions, which pass it to SDL as a format string.
+func logMessage(format string, a []any) string {
+	return strings.ReplaceAll(fmt.Sprintf(format, a...), "%", "%%")
+}
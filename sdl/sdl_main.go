@@ -1,21 +0,0 @@
-package sdl
-
-// func EnterAppMainCallbacks(argc int32, argv **byte, appinit AppInit_func, appiter AppIterate_func, appevent AppEvent_func, appquit AppQuit_func) int32 {
-//	return sdlEnterAppMainCallbacks(argc, argv, appinit, appiter, appevent, appquit)
-// }
-
-// func GDKSuspendComplete()  {
-//	sdlGDKSuspendComplete()
-// }
-
-// func main(argc int32, argv **byte) int32 {
-//	return sdlmain(argc, argv)
-// }
-
-// func RunApp(argc int32, argv **byte, mainFunction main_func, reserved unsafe.Pointer) int32 {
-//	return sdlRunApp(argc, argv, mainFunction, reserved)
-// }
-
-// func SetMainReady()  {
-//	sdlSetMainReady()
-// }
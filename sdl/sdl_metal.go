@@ -1,13 +1,8 @@
 package sdl
 
-// func Metal_CreateView(window *Window) MetalView {
-//	return sdlMetal_CreateView(window)
-// }
+import "unsafe"
 
-// func Metal_DestroyView(view MetalView)  {
-//	sdlMetal_DestroyView(view)
-// }
-
-// func Metal_GetLayer(view MetalView) unsafe.Pointer {
-//	return sdlMetal_GetLayer(view)
-// }
+// [MetalView] is a handle to a CAMetalLayer-backed NSView (macOS) or UIView (iOS/tvOS).
+//
+// [MetalView]: https://wiki.libsdl.org/SDL3/SDL_MetalView
+type MetalView unsafe.Pointer
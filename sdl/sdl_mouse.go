@@ -111,6 +111,10 @@ func CreateColorCursor(surface *Surface, hotX int32, hotY int32) *Cursor {
 //
 // [CreateAnimatedCursor]: https://wiki.libsdl.org/SDL3/SDL_CreateAnimatedCursor
 func CreateAnimatedCursor(frames *CursorFrameInfo, frameCount, hotX, hotY int32) *Cursor {
+	if sdlCreateAnimatedCursor == nil {
+		notAvailable("SDL_CreateAnimatedCursor")
+		return nil
+	}
 	return sdlCreateAnimatedCursor(frames, frameCount, hotX, hotY)
 }
 
@@ -258,12 +262,3 @@ func WarpMouseGlobal(x float32, y float32) bool {
 func WarpMouseInWindow(window *Window, x float32, y float32) {
 	sdlWarpMouseInWindow(window, x, y)
 }
-
-// [SetRelativeMouseTransform] sets a user-defined function by which to transform relative mouse inputs.
-//
-// Available since SDL 3.4.0.
-//
-// [SetRelativeMouseTransform]: https://wiki.libsdl.org/SDL3/SDL_SetRelativeMouseTransform
-// func SetRelativeMouseTransform(callback MouseMotionTransformCallback, userdata uintptr) bool {
-// 	return sdlSetRelativeMouseTransform(callback, userdata)
-// }
@@ -41,114 +41,198 @@ type InitState struct {
 	Reserved uintptr
 }
 
-// func BroadcastCondition(cond *Condition)  {
-//	sdlBroadcastCondition(cond)
-// }
+// [BroadcastCondition] restarts all threads that are waiting on the condition variable.
+//
+// [BroadcastCondition]: https://wiki.libsdl.org/SDL3/SDL_BroadcastCondition
+func BroadcastCondition(cond *Condition) {
+	sdlBroadcastCondition(cond)
+}
 
-// func CreateCondition() *Condition {
-//	return sdlCreateCondition()
-// }
+// [CreateCondition] creates a condition variable or returns nil on failure.
+//
+// [CreateCondition]: https://wiki.libsdl.org/SDL3/SDL_CreateCondition
+func CreateCondition() *Condition {
+	return sdlCreateCondition()
+}
 
-// func CreateMutex() *Mutex {
-//	return sdlCreateMutex()
-// }
+// [CreateMutex] creates a new recursive mutex or returns nil on failure.
+//
+// [CreateMutex]: https://wiki.libsdl.org/SDL3/SDL_CreateMutex
+func CreateMutex() *Mutex {
+	return sdlCreateMutex()
+}
 
-// func CreateRWLock() *RWLock {
-//	return sdlCreateRWLock()
-// }
+// [CreateRWLock] creates a new read/write lock or returns nil on failure.
+//
+// [CreateRWLock]: https://wiki.libsdl.org/SDL3/SDL_CreateRWLock
+func CreateRWLock() *RWLock {
+	return sdlCreateRWLock()
+}
 
-// func CreateSemaphore(initial_value uint32) *Semaphore {
-//	return sdlCreateSemaphore(initial_value)
-// }
+// [CreateSemaphore] creates a semaphore with an initial value or returns nil on failure.
+//
+// [CreateSemaphore]: https://wiki.libsdl.org/SDL3/SDL_CreateSemaphore
+func CreateSemaphore(initialValue uint32) *Semaphore {
+	return sdlCreateSemaphore(initialValue)
+}
 
-// func DestroyCondition(cond *Condition)  {
-//	sdlDestroyCondition(cond)
-// }
+// [DestroyCondition] destroys a condition variable.
+//
+// [DestroyCondition]: https://wiki.libsdl.org/SDL3/SDL_DestroyCondition
+func DestroyCondition(cond *Condition) {
+	sdlDestroyCondition(cond)
+}
 
-// func DestroyMutex(mutex *Mutex)  {
-//	sdlDestroyMutex(mutex)
-// }
+// [DestroyMutex] destroys a mutex created with [CreateMutex].
+//
+// [DestroyMutex]: https://wiki.libsdl.org/SDL3/SDL_DestroyMutex
+func DestroyMutex(mutex *Mutex) {
+	sdlDestroyMutex(mutex)
+}
 
-// func DestroyRWLock(rwlock *RWLock)  {
-//	sdlDestroyRWLock(rwlock)
-// }
+// [DestroyRWLock] destroys a read/write lock created with [CreateRWLock].
+//
+// [DestroyRWLock]: https://wiki.libsdl.org/SDL3/SDL_DestroyRWLock
+func DestroyRWLock(rwlock *RWLock) {
+	sdlDestroyRWLock(rwlock)
+}
 
-// func DestroySemaphore(sem *Semaphore)  {
-//	sdlDestroySemaphore(sem)
-// }
+// [DestroySemaphore] destroys a semaphore.
+//
+// [DestroySemaphore]: https://wiki.libsdl.org/SDL3/SDL_DestroySemaphore
+func DestroySemaphore(sem *Semaphore) {
+	sdlDestroySemaphore(sem)
+}
 
-// func GetSemaphoreValue(sem *Semaphore) uint32 {
-//	return sdlGetSemaphoreValue(sem)
-// }
+// [GetSemaphoreValue] gets the current value of a semaphore.
+//
+// [GetSemaphoreValue]: https://wiki.libsdl.org/SDL3/SDL_GetSemaphoreValue
+func GetSemaphoreValue(sem *Semaphore) uint32 {
+	return sdlGetSemaphoreValue(sem)
+}
 
-// func LockMutex(mutex *Mutex)  {
-//	sdlLockMutex(mutex)
-// }
+// [LockMutex] locks the mutex. It must be unlocked by the same thread, see [Mutex.Lock].
+//
+// [LockMutex]: https://wiki.libsdl.org/SDL3/SDL_LockMutex
+func LockMutex(mutex *Mutex) {
+	sdlLockMutex(mutex)
+}
 
-// func LockRWLockForReading(rwlock *RWLock)  {
-//	sdlLockRWLockForReading(rwlock)
-// }
+// [LockRWLockForReading] locks the read/write lock for read only operations.
+//
+// [LockRWLockForReading]: https://wiki.libsdl.org/SDL3/SDL_LockRWLockForReading
+func LockRWLockForReading(rwlock *RWLock) {
+	sdlLockRWLockForReading(rwlock)
+}
 
-// func LockRWLockForWriting(rwlock *RWLock)  {
-//	sdlLockRWLockForWriting(rwlock)
-// }
+// [LockRWLockForWriting] locks the read/write lock for write operations.
+//
+// [LockRWLockForWriting]: https://wiki.libsdl.org/SDL3/SDL_LockRWLockForWriting
+func LockRWLockForWriting(rwlock *RWLock) {
+	sdlLockRWLockForWriting(rwlock)
+}
 
-// func SetInitialized(state *InitState, initialized bool)  {
-//	sdlSetInitialized(state, initialized)
-// }
+// [SetInitialized] finishes an initialization state transition started by [ShouldInit] or [ShouldQuit].
+//
+// [SetInitialized]: https://wiki.libsdl.org/SDL3/SDL_SetInitialized
+func SetInitialized(state *InitState, initialized bool) {
+	sdlSetInitialized(state, initialized)
+}
 
-// func ShouldInit(state *InitState) bool {
-//	return sdlShouldInit(state)
-// }
+// [ShouldInit] returns whether initialization should be done. If true, [SetInitialized] must be called afterwards.
+//
+// [ShouldInit]: https://wiki.libsdl.org/SDL3/SDL_ShouldInit
+func ShouldInit(state *InitState) bool {
+	return sdlShouldInit(state)
+}
 
-// func ShouldQuit(state *InitState) bool {
-//	return sdlShouldQuit(state)
-// }
+// [ShouldQuit] returns whether cleanup should be done. If true, [SetInitialized] must be called afterwards.
+//
+// [ShouldQuit]: https://wiki.libsdl.org/SDL3/SDL_ShouldQuit
+func ShouldQuit(state *InitState) bool {
+	return sdlShouldQuit(state)
+}
 
-// func SignalCondition(cond *Condition)  {
-//	sdlSignalCondition(cond)
-// }
+// [SignalCondition] restarts one of the threads that are waiting on the condition variable.
+//
+// [SignalCondition]: https://wiki.libsdl.org/SDL3/SDL_SignalCondition
+func SignalCondition(cond *Condition) {
+	sdlSignalCondition(cond)
+}
 
-// func SignalSemaphore(sem *Semaphore)  {
-//	sdlSignalSemaphore(sem)
-// }
+// [SignalSemaphore] atomically increments a semaphore's value and wakes waiting threads.
+//
+// [SignalSemaphore]: https://wiki.libsdl.org/SDL3/SDL_SignalSemaphore
+func SignalSemaphore(sem *Semaphore) {
+	sdlSignalSemaphore(sem)
+}
 
-// func TryLockMutex(mutex *Mutex) bool {
-//	return sdlTryLockMutex(mutex)
-// }
+// [TryLockMutex] tries to lock a mutex without blocking.
+//
+// [TryLockMutex]: https://wiki.libsdl.org/SDL3/SDL_TryLockMutex
+func TryLockMutex(mutex *Mutex) bool {
+	return sdlTryLockMutex(mutex)
+}
 
-// func TryLockRWLockForReading(rwlock *RWLock) bool {
-//	return sdlTryLockRWLockForReading(rwlock)
-// }
+// [TryLockRWLockForReading] tries to lock a read/write lock for reading without blocking.
+//
+// [TryLockRWLockForReading]: https://wiki.libsdl.org/SDL3/SDL_TryLockRWLockForReading
+func TryLockRWLockForReading(rwlock *RWLock) bool {
+	return sdlTryLockRWLockForReading(rwlock)
+}
 
-// func TryLockRWLockForWriting(rwlock *RWLock) bool {
-//	return sdlTryLockRWLockForWriting(rwlock)
-// }
+// [TryLockRWLockForWriting] tries to lock a read/write lock for writing without blocking.
+//
+// [TryLockRWLockForWriting]: https://wiki.libsdl.org/SDL3/SDL_TryLockRWLockForWriting
+func TryLockRWLockForWriting(rwlock *RWLock) bool {
+	return sdlTryLockRWLockForWriting(rwlock)
+}
 
-// func TryWaitSemaphore(sem *Semaphore) bool {
-//	return sdlTryWaitSemaphore(sem)
-// }
+// [TryWaitSemaphore] sees if a semaphore has a positive value and decrements it if it does.
+//
+// [TryWaitSemaphore]: https://wiki.libsdl.org/SDL3/SDL_TryWaitSemaphore
+func TryWaitSemaphore(sem *Semaphore) bool {
+	return sdlTryWaitSemaphore(sem)
+}
 
-// func UnlockMutex(mutex *Mutex)  {
-//	sdlUnlockMutex(mutex)
-// }
+// [UnlockMutex] unlocks the mutex.
+//
+// [UnlockMutex]: https://wiki.libsdl.org/SDL3/SDL_UnlockMutex
+func UnlockMutex(mutex *Mutex) {
+	sdlUnlockMutex(mutex)
+}
 
-// func UnlockRWLock(rwlock *RWLock)  {
-//	sdlUnlockRWLock(rwlock)
-// }
+// [UnlockRWLock] unlocks the read/write lock.
+//
+// [UnlockRWLock]: https://wiki.libsdl.org/SDL3/SDL_UnlockRWLock
+func UnlockRWLock(rwlock *RWLock) {
+	sdlUnlockRWLock(rwlock)
+}
 
-// func WaitCondition(cond *Condition, mutex *Mutex)  {
-//	sdlWaitCondition(cond, mutex)
-// }
+// [WaitCondition] waits until a condition variable is signaled. The mutex must be locked.
+//
+// [WaitCondition]: https://wiki.libsdl.org/SDL3/SDL_WaitCondition
+func WaitCondition(cond *Condition, mutex *Mutex) {
+	sdlWaitCondition(cond, mutex)
+}
 
-// func WaitConditionTimeout(cond *Condition, mutex *Mutex, timeoutMS int32) bool {
-//	return sdlWaitConditionTimeout(cond, mutex, timeoutMS)
-// }
+// [WaitConditionTimeout] waits until a condition variable is signaled or a certain time has passed and reports whether it was signaled.
+//
+// [WaitConditionTimeout]: https://wiki.libsdl.org/SDL3/SDL_WaitConditionTimeout
+func WaitConditionTimeout(cond *Condition, mutex *Mutex, timeoutMS int32) bool {
+	return sdlWaitConditionTimeout(cond, mutex, timeoutMS)
+}
 
-// func WaitSemaphore(sem *Semaphore)  {
-//	sdlWaitSemaphore(sem)
-// }
+// [WaitSemaphore] waits until a semaphore has a positive value and then decrements it.
+//
+// [WaitSemaphore]: https://wiki.libsdl.org/SDL3/SDL_WaitSemaphore
+func WaitSemaphore(sem *Semaphore) {
+	sdlWaitSemaphore(sem)
+}
 
-// func WaitSemaphoreTimeout(sem *Semaphore, timeoutMS int32) bool {
-//	return sdlWaitSemaphoreTimeout(sem, timeoutMS)
-// }
+// [WaitSemaphoreTimeout] waits until a semaphore has a positive value and then decrements it, or until the timeout expires. It reports whether the value was decremented.
+//
+// [WaitSemaphoreTimeout]: https://wiki.libsdl.org/SDL3/SDL_WaitSemaphoreTimeout
+func WaitSemaphoreTimeout(sem *Semaphore, timeoutMS int32) bool {
+	return sdlWaitSemaphoreTimeout(sem, timeoutMS)
+}
@@ -49,12 +49,3 @@ const (
 	PenDeviceTypeDirect                            // Pen touches display.
 	PenDeviceTypeIndirect                          // Pen touches something that isn't the display.
 )
-
-// [GetPenDeviceType] gets the device type of the given pen.
-//
-// Available since SDL 3.4.0.
-//
-// [GetPenDeviceType]: https://wiki.libsdl.org/SDL3/SDL_GetPenDeviceType
-// func GetPenDeviceType(instanceId PenID) PenDeviceType {
-// 	return sdlGetPenDeviceType(instanceId)
-// }
@@ -448,10 +448,6 @@ func DestroyPalette(palette *Palette) {
 	sdlDestroyPalette(palette)
 }
 
-// func GetMasksForPixelFormat(format PixelFormat, bpp *int32, Rmask *uint32, Gmask *uint32, Bmask *uint32, Amask *uint32) bool {
-//	return sdlGetMasksForPixelFormat(format, bpp, Rmask, Gmask, Bmask, Amask)
-// }
-
 // [GetPixelFormatDetails] creates an [PixelFormatDetails] structure corresponding to a pixel format.
 //
 // [GetPixelFormatDetails]: https://wiki.libsdl.org/SDL3/SDL_GetPixelFormatDetails
@@ -459,22 +455,6 @@ func GetPixelFormatDetails(format PixelFormat) *PixelFormatDetails {
 	return sdlGetPixelFormatDetails(format)
 }
 
-// func GetPixelFormatForMasks(bpp int32, Rmask uint32, Gmask uint32, Bmask uint32, Amask uint32) PixelFormat {
-//	return sdlGetPixelFormatForMasks(bpp, Rmask, Gmask, Bmask, Amask)
-// }
-
-// func GetPixelFormatName(format PixelFormat) string {
-//	return sdlGetPixelFormatName(format)
-// }
-
-// func GetRGB(pixel uint32, format *PixelFormatDetails, palette *Palette, r *uint8, g *uint8, b *uint8)  {
-//	sdlGetRGB(pixel, format, palette, r, g, b)
-// }
-
-// func GetRGBA(pixel uint32, format *PixelFormatDetails, palette *Palette, r *uint8, g *uint8, b *uint8, a *uint8)  {
-//	sdlGetRGBA(pixel, format, palette, r, g, b, a)
-// }
-
 // [MapRGB] maps an RGB triple to an opaque pixel value for a given pixel format.
 //
 // [MapRGB]: https://wiki.libsdl.org/SDL3/SDL_MapRGB
@@ -482,10 +462,6 @@ func MapRGB(format *PixelFormatDetails, palette *Palette, r uint8, g uint8, b ui
 	return sdlMapRGB(format, palette, r, g, b)
 }
 
-// func MapRGBA(format *PixelFormatDetails, palette *Palette, r uint8, g uint8, b uint8, a uint8) uint32 {
-//	return sdlMapRGBA(format, palette, r, g, b, a)
-// }
-
 // [SetPaletteColors] sets a range of colors in a palette.
 //
 // [SetPaletteColors]: https://wiki.libsdl.org/SDL3/SDL_SetPaletteColors
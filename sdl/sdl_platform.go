@@ -1,5 +0,0 @@
-package sdl
-
-// func GetPlatform() string {
-//	return sdlGetPlatform()
-// }
@@ -1,5 +1,7 @@
 package sdl
 
+import "unsafe"
+
 const (
 	PropProcessCreateArgsPointer            = "SDL.process.create.args"
 	PropProcessCreateEnvironmentPointer     = "SDL.process.create.environment"
@@ -37,38 +39,65 @@ const (
 	ProcessStdioRedirect                   // The I/O stream is redirected to an existing [IOStream].
 )
 
-// func CreateProcess(args **byte, pipe_stdio bool) *Process {
-//	return sdlCreateProcess(args, pipe_stdio)
-// }
+// [CreateProcess] creates a new process or returns nil on failure. The args are terminated by a nil pointer. See [Command] for a Go interface.
+//
+// [CreateProcess]: https://wiki.libsdl.org/SDL3/SDL_CreateProcess
+func CreateProcess(args **byte, pipeStdio bool) *Process {
+	return sdlCreateProcess(args, pipeStdio)
+}
 
-// func CreateProcessWithProperties(props PropertiesID) *Process {
-//	return sdlCreateProcessWithProperties(props)
-// }
+// [CreateProcessWithProperties] creates a new process with the specified properties or returns nil on failure.
+//
+// [CreateProcessWithProperties]: https://wiki.libsdl.org/SDL3/SDL_CreateProcessWithProperties
+func CreateProcessWithProperties(props PropertiesID) *Process {
+	return sdlCreateProcessWithProperties(props)
+}
 
-// func DestroyProcess(process *Process)  {
-//	sdlDestroyProcess(process)
-// }
+// [DestroyProcess] stops reading from or writing to the process and frees its resources. It doesn't kill the process.
+//
+// [DestroyProcess]: https://wiki.libsdl.org/SDL3/SDL_DestroyProcess
+func DestroyProcess(process *Process) {
+	sdlDestroyProcess(process)
+}
 
-// func GetProcessInput(process *Process) *IOStream {
-//	return sdlGetProcessInput(process)
-// }
+// [GetProcessInput] gets the [IOStream] associated with process standard input.
+//
+// [GetProcessInput]: https://wiki.libsdl.org/SDL3/SDL_GetProcessInput
+func GetProcessInput(process *Process) *IOStream {
+	return sdlGetProcessInput(process)
+}
 
-// func GetProcessOutput(process *Process) *IOStream {
-//	return sdlGetProcessOutput(process)
-// }
+// [GetProcessOutput] gets the [IOStream] associated with process standard output.
+//
+// [GetProcessOutput]: https://wiki.libsdl.org/SDL3/SDL_GetProcessOutput
+func GetProcessOutput(process *Process) *IOStream {
+	return sdlGetProcessOutput(process)
+}
 
-// func GetProcessProperties(process *Process) PropertiesID {
-//	return sdlGetProcessProperties(process)
-// }
+// [GetProcessProperties] gets the properties associated with a process.
+//
+// [GetProcessProperties]: https://wiki.libsdl.org/SDL3/SDL_GetProcessProperties
+func GetProcessProperties(process *Process) PropertiesID {
+	return sdlGetProcessProperties(process)
+}
 
-// func KillProcess(process *Process, force bool) bool {
-//	return sdlKillProcess(process, force)
-// }
+// [KillProcess] stops a process.
+//
+// [KillProcess]: https://wiki.libsdl.org/SDL3/SDL_KillProcess
+func KillProcess(process *Process, force bool) bool {
+	return sdlKillProcess(process, force)
+}
 
-// func ReadProcess(process *Process, datasize *uint64, exitcode *int32) unsafe.Pointer {
-//	return sdlReadProcess(process, datasize, exitcode)
-// }
+// [ReadProcess] reads all the output from a process and waits for it to exit. The data must be freed with [Free].
+//
+// [ReadProcess]: https://wiki.libsdl.org/SDL3/SDL_ReadProcess
+func ReadProcess(process *Process, datasize *uint64, exitcode *int32) unsafe.Pointer {
+	return sdlReadProcess(process, datasize, exitcode)
+}
 
-// func WaitProcess(process *Process, block bool, exitcode *int32) bool {
-//	return sdlWaitProcess(process, block, exitcode)
-// }
+// [WaitProcess] waits for a process to finish and reports whether it exited. The exit code is stored in exitcode, unless it is nil.
+//
+// [WaitProcess]: https://wiki.libsdl.org/SDL3/SDL_WaitProcess
+func WaitProcess(process *Process, block bool, exitcode *int32) bool {
+	return sdlWaitProcess(process, block, exitcode)
+}
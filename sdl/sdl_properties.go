@@ -31,6 +31,8 @@ type PropertiesID uint32
 // [CleanupPropertyCallback]: https://wiki.libsdl.org/SDL3/SDL_CleanupPropertyCallback
 type CleanupPropertyCallback uintptr
 
+// NewCleanupPropertyCallback converts the Go function to a C function pointer, which is never freed.
+// Prefer [SetPointerPropertyWithCleanupFunc] when properties are set repeatedly.
 func NewCleanupPropertyCallback(callback func(userdata, value unsafe.Pointer)) CleanupPropertyCallback {
 	cb := purego.NewCallback(func(userdata, value unsafe.Pointer) uintptr {
 		callback(userdata, value)
@@ -45,6 +47,8 @@ func NewCleanupPropertyCallback(callback func(userdata, value unsafe.Pointer)) C
 // [EnumeratePropertiesCallback]: https://wiki.libsdl.org/SDL3/SDL_EnumeratePropertiesCallback
 type EnumeratePropertiesCallback uintptr
 
+// NewEnumeratePropertiesCallback converts the Go function to a C function pointer, which is never freed.
+// Prefer [EnumeratePropertiesFunc] when properties are enumerated repeatedly.
 func NewEnumeratePropertiesCallback(callback func(userdata unsafe.Pointer, props PropertiesID, name string)) EnumeratePropertiesCallback {
 	cb := purego.NewCallback(func(userdata unsafe.Pointer, props PropertiesID, name *byte) uintptr {
 		callback(userdata, props, convert.ToString(name))
@@ -54,6 +58,32 @@ func NewEnumeratePropertiesCallback(callback func(userdata unsafe.Pointer, props
 	return EnumeratePropertiesCallback(cb)
 }
 
+var (
+	cleanupPropertyTrampoline     trampoline
+	enumeratePropertiesTrampoline trampoline
+)
+
+// cleanupPropertyCallbackFunc returns the shared trampoline for cleanup functions. SDL calls it exactly once
+// per property, so the handle is released afterwards.
+func cleanupPropertyCallbackFunc() CleanupPropertyCallback {
+	return CleanupPropertyCallback(cleanupPropertyTrampoline.get(func(userdata uintptr, value unsafe.Pointer) uintptr {
+		if cleanup, ok := lookupCallback(userdata).(func(unsafe.Pointer)); ok {
+			cleanup(value)
+		}
+		releaseCallback(userdata)
+		return 0
+	}))
+}
+
+func enumeratePropertiesCallbackFunc() EnumeratePropertiesCallback {
+	return EnumeratePropertiesCallback(enumeratePropertiesTrampoline.get(func(userdata uintptr, props PropertiesID, name *byte) uintptr {
+		if callback, ok := lookupCallback(userdata).(func(PropertiesID, string)); ok {
+			callback(props, convert.ToString(name))
+		}
+		return 0
+	}))
+}
+
 // [ClearProperty] clears a property from a group of properties.
 //
 // [ClearProperty]: https://wiki.libsdl.org/SDL3/SDL_ClearProperty
@@ -89,6 +119,13 @@ func EnumerateProperties(props PropertiesID, callback EnumeratePropertiesCallbac
 	return sdlEnumerateProperties(props, callback, userdata)
 }
 
+// EnumeratePropertiesFunc is like [EnumerateProperties], but takes a Go function.
+func EnumeratePropertiesFunc(props PropertiesID, callback func(props PropertiesID, name string)) bool {
+	h := newCallbackHandle(callback, nil)
+	defer h.Release()
+	return sdlEnumerateProperties(props, enumeratePropertiesCallbackFunc(), h.pointer())
+}
+
 // [GetBooleanProperty] gets a boolean property from a group of properties.
 //
 // [GetBooleanProperty]: https://wiki.libsdl.org/SDL3/SDL_GetBooleanProperty
@@ -187,6 +224,14 @@ func SetPointerPropertyWithCleanup(props PropertiesID, name string, value unsafe
 	return sdlSetPointerPropertyWithCleanup(props, name, value, cleanup, userdata)
 }
 
+// SetPointerPropertyWithCleanupFunc is like [SetPointerPropertyWithCleanup], but takes a Go function.
+//
+// The cleanup function is called once, when the property is deleted or replaced, or immediately if setting fails.
+func SetPointerPropertyWithCleanupFunc(props PropertiesID, name string, value unsafe.Pointer, cleanup func(value unsafe.Pointer)) bool {
+	h := newCallbackHandle(cleanup, nil)
+	return sdlSetPointerPropertyWithCleanup(props, name, value, cleanupPropertyCallbackFunc(), h.pointer())
+}
+
 // [SetStringProperty] sets a string property in a group of properties.
 //
 // [SetStringProperty]: https://wiki.libsdl.org/SDL3/SDL_SetStringProperty
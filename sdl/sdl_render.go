@@ -338,6 +338,10 @@ func CreateRendererWithProperties(props PropertiesID) *Renderer {
 //
 // [CreateGPURenderer]: https://wiki.libsdl.org/SDL3/SDL_CreateGPURenderer
 func CreateGPURenderer(device *GPUDevice, window *Window) *Renderer {
+	if sdlCreateGPURenderer == nil {
+		notAvailable("SDL_CreateGPURenderer")
+		return nil
+	}
 	return sdlCreateGPURenderer(device, window)
 }
 
@@ -347,6 +351,10 @@ func CreateGPURenderer(device *GPUDevice, window *Window) *Renderer {
 //
 // [GetGPURendererDevice]: https://wiki.libsdl.org/SDL3/SDL_GetGPURendererDevice
 func GetGPURendererDevice(renderer *Renderer) *GPUDevice {
+	if sdlGetGPURendererDevice == nil {
+		notAvailable("SDL_GetGPURendererDevice")
+		return nil
+	}
 	return sdlGetGPURendererDevice(renderer)
 }
 
@@ -602,6 +610,10 @@ func GetTextureSize(texture *Texture, w *float32, h *float32) bool {
 //
 // [SetTexturePalette]: https://wiki.libsdl.org/SDL3/SDL_SetTexturePalette
 func SetTexturePalette(texture *Texture, palette *Palette) bool {
+	if sdlSetTexturePalette == nil {
+		notAvailable("SDL_SetTexturePalette")
+		return false
+	}
 	return sdlSetTexturePalette(texture, palette)
 }
 
@@ -611,6 +623,10 @@ func SetTexturePalette(texture *Texture, palette *Palette) bool {
 //
 // [GetTexturePalette]: https://wiki.libsdl.org/SDL3/SDL_GetTexturePalette
 func GetTexturePalette(texture *Texture) *Palette {
+	if sdlGetTexturePalette == nil {
+		notAvailable("SDL_GetTexturePalette")
+		return nil
+	}
 	return sdlGetTexturePalette(texture)
 }
 
@@ -662,6 +678,10 @@ func RenderDebugTextFormat(renderer *Renderer, x float32, y float32, format stri
 //
 // [SetDefaultTextureScaleMode]: https://wiki.libsdl.org/SDL3/SDL_SetDefaultTextureScaleMode
 func SetDefaultTextureScaleMode(renderer *Renderer, scaleMode ScaleMode) bool {
+	if sdlSetDefaultTextureScaleMode == nil {
+		notAvailable("SDL_SetDefaultTextureScaleMode")
+		return false
+	}
 	return sdlSetDefaultTextureScaleMode(renderer, scaleMode)
 }
 
@@ -671,45 +691,13 @@ func SetDefaultTextureScaleMode(renderer *Renderer, scaleMode ScaleMode) bool {
 //
 // [GetDefaultTextureScaleMode]: https://wiki.libsdl.org/SDL3/SDL_GetDefaultTextureScaleMode
 func GetDefaultTextureScaleMode(renderer *Renderer, scaleMode *ScaleMode) bool {
+	if sdlGetDefaultTextureScaleMode == nil {
+		notAvailable("SDL_GetDefaultTextureScaleMode")
+		return false
+	}
 	return sdlGetDefaultTextureScaleMode(renderer, scaleMode)
 }
 
-// [CreateGPURenderState] creates custom GPU render state.
-//
-// Available since SDL 3.4.0.
-//
-// [CreateGPURenderState]: https://wiki.libsdl.org/SDL3/SDL_CreateGPURenderState
-// func CreateGPURenderState(renderer *Renderer, createinfo *GPURenderStateCreateInfo) *GPURenderState {
-// 	return sdlCreateGPURenderState(renderer, createinfo)
-// }
-
-// [SetGPURenderStateFragmentUniforms] sets fragment shader uniform variables in a custom GPU render state.
-//
-// Available since SDL 3.4.0.
-//
-// [SetGPURenderStateFragmentUniforms]: https://wiki.libsdl.org/SDL3/SDL_SetGPURenderStateFragmentUniforms
-// func SetGPURenderStateFragmentUniforms(state *GPURenderState, slotIndex uint32, data uintptr, length uint32) bool {
-// 	return sdlSetGPURenderStateFragmentUniforms(state, slotIndex, data, length)
-// }
-
-// [SetGPURenderState] sets custom GPU render state.
-//
-// Available since SDL 3.4.0.
-//
-// [SetGPURenderState]: https://wiki.libsdl.org/SDL3/SDL_SetGPURenderState
-// func SetGPURenderState(renderer *Renderer, state *GPURenderState) bool {
-// 	return sdlSetGPURenderState(renderer, state)
-// }
-
-// [DestroyGPURenderState] destroys custom GPU render state.
-//
-// Available since SDL 3.4.0.
-//
-// [DestroyGPURenderState]: https://wiki.libsdl.org/SDL3/SDL_DestroyGPURenderState
-// func DestroyGPURenderState(state *GPURenderState) {
-// 	sdlDestroyGPURenderState(state)
-// }
-
 // [RenderFillRects] fills some number of rectangles on the current rendering target with the drawing color at subpixel precision.
 //
 // [RenderFillRects]: https://wiki.libsdl.org/SDL3/SDL_RenderFillRects
@@ -799,6 +787,10 @@ func RenderGeometryRaw(renderer *Renderer, texture *Texture, xy []FPoint, color
 //
 // [SetRenderTextureAddressMode]: https://wiki.libsdl.org/SDL3/SDL_SetRenderTextureAddressMode
 func SetRenderTextureAddressMode(renderer *Renderer, uMode, vMode TextureAddressMode) bool {
+	if sdlSetRenderTextureAddressMode == nil {
+		notAvailable("SDL_SetRenderTextureAddressMode")
+		return false
+	}
 	return sdlSetRenderTextureAddressMode(renderer, uMode, vMode)
 }
 
@@ -808,6 +800,10 @@ func SetRenderTextureAddressMode(renderer *Renderer, uMode, vMode TextureAddress
 //
 // [GetRenderTextureAddressMode]: https://wiki.libsdl.org/SDL3/SDL_GetRenderTextureAddressMode
 func GetRenderTextureAddressMode(renderer *Renderer, uMode, vMode *TextureAddressMode) bool {
+	if sdlGetRenderTextureAddressMode == nil {
+		notAvailable("SDL_GetRenderTextureAddressMode")
+		return false
+	}
 	return sdlGetRenderTextureAddressMode(renderer, uMode, vMode)
 }
 
@@ -884,6 +880,10 @@ func RenderTexture9Grid(renderer *Renderer, texture *Texture, srcrect *FRect, le
 //
 // [RenderTexture9GridTiled]: https://wiki.libsdl.org/SDL3/SDL_RenderTexture9GridTiled
 func RenderTexture9GridTiled(renderer *Renderer, texture *Texture, srcrect *FRect, leftWidth, rightWidth, topHeight, bottomHeight, scale float32, dstrect *FRect, tileScale float32) bool {
+	if sdlRenderTexture9GridTiled == nil {
+		notAvailable("SDL_RenderTexture9GridTiled")
+		return false
+	}
 	return sdlRenderTexture9GridTiled(renderer, texture, srcrect, leftWidth, rightWidth, topHeight, bottomHeight, scale, dstrect, tileScale)
 }
 
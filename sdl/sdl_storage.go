@@ -1,21 +1,30 @@
 package sdl
 
+import (
+	"unsafe"
+
+	"github.com/jupiterrider/purego-sdl3/internal/convert"
+)
+
 // [StorageInterface] defines function interface for [Storage].
 //
+// The fields are C function pointers, which are optional unless noted otherwise. Version must be set to the size of the struct.
+// See [OpenStorageBackend] for storage implemented in Go.
+//
 // [StorageInterface]: https://wiki.libsdl.org/SDL3/SDL_StorageInterface
 type StorageInterface struct {
-	Version        uint32                                                                                                  // The version of this interface.
-	Close          *func(userdata uintptr) bool                                                                            // Called when the storage is closed.
-	Ready          *func(userdata uintptr) bool                                                                            // Optional, returns whether the storage is currently ready for access.
-	Enumerate      *func(userdata uintptr, path *byte, callback EnumerateDirectoryCallback, callbackUserdata uintptr) bool // Enumerate a directory, optional for write-only storage.
-	Info           *func(userdata uintptr, path *byte, info *PathInfo) bool                                                // Get path information, optional for write-only storage.
-	ReadFile       *func(userdata uintptr, path *byte, destination uintptr, length uint64) bool                            // Read a file from storage, optional for write-only storage.
-	WriteFile      *func(userdata uintptr, path *byte, source uintptr, length uint64) bool                                 // Write a file to storage, optional for read-only storage.
-	Mkdir          *func(userdata uintptr, path *byte) bool                                                                // Create a directory, optional for read-only storage.
-	Remove         *func(userdata uintptr, path *byte) bool                                                                // Remove a file or empty directory, optional for read-only storage.
-	Rename         *func(userdata uintptr, oldpath, newpath *byte) bool                                                    // Rename a path, optional for read-only storage.
-	Copy           *func(userdata uintptr, oldpath, newpath *byte) bool                                                    // Copy a file, optional for read-only storage.
-	SpaceRemaining *func(userdata uintptr) uint64                                                                          // Get the space remaining, optional for read-only storage.
+	Version        uint32  // The version of this interface.
+	Close          uintptr // func(userdata unsafe.Pointer) bool. Called when the storage is closed.
+	Ready          uintptr // func(userdata unsafe.Pointer) bool. Returns whether the storage is currently ready for access.
+	Enumerate      uintptr // func(userdata unsafe.Pointer, path *byte, callback EnumerateDirectoryCallback, callbackUserdata unsafe.Pointer) bool. Enumerate a directory, optional for write-only storage.
+	Info           uintptr // func(userdata unsafe.Pointer, path *byte, info *PathInfo) bool. Get path information, optional for write-only storage.
+	ReadFile       uintptr // func(userdata unsafe.Pointer, path *byte, destination unsafe.Pointer, length uint64) bool. Read a file from storage, optional for write-only storage.
+	WriteFile      uintptr // func(userdata unsafe.Pointer, path *byte, source unsafe.Pointer, length uint64) bool. Write a file to storage, optional for read-only storage.
+	Mkdir          uintptr // func(userdata unsafe.Pointer, path *byte) bool. Create a directory, optional for read-only storage.
+	Remove         uintptr // func(userdata unsafe.Pointer, path *byte) bool. Remove a file or empty directory, optional for read-only storage.
+	Rename         uintptr // func(userdata unsafe.Pointer, oldpath, newpath *byte) bool. Rename a path, optional for read-only storage.
+	Copy           uintptr // func(userdata unsafe.Pointer, oldpath, newpath *byte) bool. Copy a file, optional for read-only storage.
+	SpaceRemaining uintptr // func(userdata unsafe.Pointer) uint64. Get the space remaining, optional for read-only storage.
 }
 
 // [Storage] is an abstract interface for filesystem access.
@@ -23,70 +32,130 @@ type StorageInterface struct {
 // [Storage]: https://wiki.libsdl.org/SDL3/SDL_Storage
 type Storage struct{}
 
-// func CloseStorage(storage *Storage) bool {
-//	return sdlCloseStorage(storage)
-// }
+// [CloseStorage] closes and frees a storage container.
+//
+// [CloseStorage]: https://wiki.libsdl.org/SDL3/SDL_CloseStorage
+func CloseStorage(storage *Storage) bool {
+	return sdlCloseStorage(storage)
+}
 
-// func CopyStorageFile(storage *Storage, oldpath string, newpath string) bool {
-//	return sdlCopyStorageFile(storage, oldpath, newpath)
-// }
+// [CopyStorageFile] copies a file in a writable storage container.
+//
+// [CopyStorageFile]: https://wiki.libsdl.org/SDL3/SDL_CopyStorageFile
+func CopyStorageFile(storage *Storage, oldpath string, newpath string) bool {
+	return sdlCopyStorageFile(storage, oldpath, newpath)
+}
 
-// func CreateStorageDirectory(storage *Storage, path string) bool {
-//	return sdlCreateStorageDirectory(storage, path)
-// }
+// [CreateStorageDirectory] creates a directory in a writable storage container.
+//
+// [CreateStorageDirectory]: https://wiki.libsdl.org/SDL3/SDL_CreateStorageDirectory
+func CreateStorageDirectory(storage *Storage, path string) bool {
+	return sdlCreateStorageDirectory(storage, path)
+}
 
-// func EnumerateStorageDirectory(storage *Storage, path string, callback EnumerateDirectoryCallback, userdata unsafe.Pointer) bool {
-//	return sdlEnumerateStorageDirectory(storage, path, callback, userdata)
-// }
+// [EnumerateStorageDirectory] enumerates a directory in a storage container through a callback function. An empty path enumerates the root.
+//
+// [EnumerateStorageDirectory]: https://wiki.libsdl.org/SDL3/SDL_EnumerateStorageDirectory
+func EnumerateStorageDirectory(storage *Storage, path string, callback EnumerateDirectoryCallback, userdata unsafe.Pointer) bool {
+	return sdlEnumerateStorageDirectory(storage, convert.ToBytePtrNullable(path), callback, userdata)
+}
 
-// func GetStorageFileSize(storage *Storage, path string, length *uint64) bool {
-//	return sdlGetStorageFileSize(storage, path, length)
-// }
+// EnumerateStorageDirectoryFunc is like [EnumerateStorageDirectory], but takes a Go function.
+// The dirname passed to the callback ends with a slash, unless it is empty.
+func EnumerateStorageDirectoryFunc(storage *Storage, path string, callback func(dirname, fname string) EnumerationResult) bool {
+	h := newCallbackHandle(callback, nil)
+	defer h.Release()
+	return sdlEnumerateStorageDirectory(storage, convert.ToBytePtrNullable(path), enumerateDirectoryCallbackFunc(), h.pointer())
+}
 
-// func GetStoragePathInfo(storage *Storage, path string, info *PathInfo) bool {
-//	return sdlGetStoragePathInfo(storage, path, info)
-// }
+// [GetStorageFileSize] queries the size of a file within a storage container.
+//
+// [GetStorageFileSize]: https://wiki.libsdl.org/SDL3/SDL_GetStorageFileSize
+func GetStorageFileSize(storage *Storage, path string, length *uint64) bool {
+	return sdlGetStorageFileSize(storage, path, length)
+}
 
-// func GetStorageSpaceRemaining(storage *Storage) uint64 {
-//	return sdlGetStorageSpaceRemaining(storage)
-// }
+// [GetStoragePathInfo] gets information about a filesystem path in a storage container.
+//
+// [GetStoragePathInfo]: https://wiki.libsdl.org/SDL3/SDL_GetStoragePathInfo
+func GetStoragePathInfo(storage *Storage, path string, info *PathInfo) bool {
+	return sdlGetStoragePathInfo(storage, path, info)
+}
 
-// func GlobStorageDirectory(storage *Storage, path string, pattern string, flags GlobFlags, count *int32) **byte {
-//	return sdlGlobStorageDirectory(storage, path, pattern, flags, count)
-// }
+// [GetStorageSpaceRemaining] queries the remaining space in a storage container.
+//
+// [GetStorageSpaceRemaining]: https://wiki.libsdl.org/SDL3/SDL_GetStorageSpaceRemaining
+func GetStorageSpaceRemaining(storage *Storage) uint64 {
+	return sdlGetStorageSpaceRemaining(storage)
+}
 
-// func OpenFileStorage(path string) *Storage {
-//	return sdlOpenFileStorage(path)
-// }
+// [GlobStorageDirectory] enumerates a directory tree, filtered by pattern, and returns a list. The list must be freed with [Free]. An empty path starts at the root and an empty pattern matches everything.
+//
+// [GlobStorageDirectory]: https://wiki.libsdl.org/SDL3/SDL_GlobStorageDirectory
+func GlobStorageDirectory(storage *Storage, path string, pattern string, flags GlobFlags, count *int32) **byte {
+	return sdlGlobStorageDirectory(storage, convert.ToBytePtrNullable(path), convert.ToBytePtrNullable(pattern), flags, count)
+}
 
-// func OpenStorage(iface *StorageInterface, userdata unsafe.Pointer) *Storage {
-//	return sdlOpenStorage(iface, userdata)
-// }
+// [OpenFileStorage] opens a container for local filesystem storage or returns nil on failure.
+//
+// [OpenFileStorage]: https://wiki.libsdl.org/SDL3/SDL_OpenFileStorage
+func OpenFileStorage(path string) *Storage {
+	return sdlOpenFileStorage(path)
+}
 
-// func OpenTitleStorage(override string, props PropertiesID) *Storage {
-//	return sdlOpenTitleStorage(override, props)
-// }
+// [OpenStorage] opens a container using a client-provided storage interface or returns nil on failure.
+// The interface is copied.
+//
+// [OpenStorage]: https://wiki.libsdl.org/SDL3/SDL_OpenStorage
+func OpenStorage(iface *StorageInterface, userdata unsafe.Pointer) *Storage {
+	return sdlOpenStorage(iface, userdata)
+}
 
-// func OpenUserStorage(org string, app string, props PropertiesID) *Storage {
-//	return sdlOpenUserStorage(org, app, props)
-// }
+// [OpenTitleStorage] opens a read-only container for the application's filesystem or returns nil on failure. An empty override selects the default location.
+//
+// [OpenTitleStorage]: https://wiki.libsdl.org/SDL3/SDL_OpenTitleStorage
+func OpenTitleStorage(override string, props PropertiesID) *Storage {
+	return sdlOpenTitleStorage(convert.ToBytePtrNullable(override), props)
+}
 
-// func ReadStorageFile(storage *Storage, path string, destination unsafe.Pointer, length uint64) bool {
-//	return sdlReadStorageFile(storage, path, destination, length)
-// }
+// [OpenUserStorage] opens a container for a user's unique read/write filesystem or returns nil on failure.
+//
+// [OpenUserStorage]: https://wiki.libsdl.org/SDL3/SDL_OpenUserStorage
+func OpenUserStorage(org string, app string, props PropertiesID) *Storage {
+	return sdlOpenUserStorage(org, app, props)
+}
 
-// func RemoveStoragePath(storage *Storage, path string) bool {
-//	return sdlRemoveStoragePath(storage, path)
-// }
+// [ReadStorageFile] synchronously reads a file from a storage container into a client-provided buffer. The length must match the file size.
+//
+// [ReadStorageFile]: https://wiki.libsdl.org/SDL3/SDL_ReadStorageFile
+func ReadStorageFile(storage *Storage, path string, destination unsafe.Pointer, length uint64) bool {
+	return sdlReadStorageFile(storage, path, destination, length)
+}
 
-// func RenameStoragePath(storage *Storage, oldpath string, newpath string) bool {
-//	return sdlRenameStoragePath(storage, oldpath, newpath)
-// }
+// [RemoveStoragePath] removes a file or an empty directory in a writable storage container.
+//
+// [RemoveStoragePath]: https://wiki.libsdl.org/SDL3/SDL_RemoveStoragePath
+func RemoveStoragePath(storage *Storage, path string) bool {
+	return sdlRemoveStoragePath(storage, path)
+}
 
-// func StorageReady(storage *Storage) bool {
-//	return sdlStorageReady(storage)
-// }
+// [RenameStoragePath] renames a file or directory in a writable storage container.
+//
+// [RenameStoragePath]: https://wiki.libsdl.org/SDL3/SDL_RenameStoragePath
+func RenameStoragePath(storage *Storage, oldpath string, newpath string) bool {
+	return sdlRenameStoragePath(storage, oldpath, newpath)
+}
 
-// func WriteStorageFile(storage *Storage, path string, source unsafe.Pointer, length uint64) bool {
-//	return sdlWriteStorageFile(storage, path, source, length)
-// }
+// [StorageReady] checks if the storage container is ready to use.
+//
+// [StorageReady]: https://wiki.libsdl.org/SDL3/SDL_StorageReady
+func StorageReady(storage *Storage) bool {
+	return sdlStorageReady(storage)
+}
+
+// [WriteStorageFile] synchronously writes a file from client memory into a storage container.
+//
+// [WriteStorageFile]: https://wiki.libsdl.org/SDL3/SDL_WriteStorageFile
+func WriteStorageFile(storage *Storage, path string, source unsafe.Pointer, length uint64) bool {
+	return sdlWriteStorageFile(storage, path, source, length)
+}
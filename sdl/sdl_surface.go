@@ -100,43 +100,19 @@ func BlitSurface(src *Surface, srcrect *Rect, dst *Surface, dstrect *Rect) bool
 	return sdlBlitSurface(src, srcrect, dst, dstrect)
 }
 
-// func BlitSurface9Grid(src *Surface, srcrect *Rect, left_width int32, right_width int32, top_height int32, bottom_height int32, scale float32, scaleMode ScaleMode, dst *Surface, dstrect *Rect) bool {
-//	return sdlBlitSurface9Grid(src, srcrect, left_width, right_width, top_height, bottom_height, scale, scaleMode, dst, dstrect)
-// }
-
-// func BlitSurfaceScaled(src *Surface, srcrect *Rect, dst *Surface, dstrect *Rect, scaleMode ScaleMode) bool {
-//	return sdlBlitSurfaceScaled(src, srcrect, dst, dstrect, scaleMode)
-// }
-
-// func BlitSurfaceTiled(src *Surface, srcrect *Rect, dst *Surface, dstrect *Rect) bool {
-//	return sdlBlitSurfaceTiled(src, srcrect, dst, dstrect)
-// }
-
-// func BlitSurfaceTiledWithScale(src *Surface, srcrect *Rect, scale float32, scaleMode ScaleMode, dst *Surface, dstrect *Rect) bool {
-//	return sdlBlitSurfaceTiledWithScale(src, srcrect, scale, scaleMode, dst, dstrect)
-// }
-
-// func BlitSurfaceUnchecked(src *Surface, srcrect *Rect, dst *Surface, dstrect *Rect) bool {
-//	return sdlBlitSurfaceUnchecked(src, srcrect, dst, dstrect)
-// }
-
-// func BlitSurfaceUncheckedScaled(src *Surface, srcrect *Rect, dst *Surface, dstrect *Rect, scaleMode ScaleMode) bool {
-//	return sdlBlitSurfaceUncheckedScaled(src, srcrect, dst, dstrect, scaleMode)
-// }
-
 // [StretchSurface] performs a stretched pixel copy from one surface to another.
 //
 // Available since SDL 3.4.0.
 //
 // [StretchSurface]: https://wiki.libsdl.org/SDL3/SDL_StretchSurface
 func StretchSurface(src *Surface, srcrect *Rect, dst *Surface, dstrect *Rect, scaleMode ScaleMode) bool {
+	if sdlStretchSurface == nil {
+		notAvailable("SDL_StretchSurface")
+		return false
+	}
 	return sdlStretchSurface(src, srcrect, dst, dstrect, scaleMode)
 }
 
-// func ClearSurface(surface *Surface, r float32, g float32, b float32, a float32) bool {
-//	return sdlClearSurface(surface, r, g, b, a)
-// }
-
 // [ConvertPixels] copies a block of pixels of one format to another format.
 //
 // [ConvertPixels]: https://wiki.libsdl.org/SDL3/SDL_ConvertPixels
@@ -200,10 +176,6 @@ func FillSurfaceRect(dst *Surface, rect *Rect, color uint32) bool {
 	return sdlFillSurfaceRect(dst, rect, color)
 }
 
-// func FillSurfaceRects(dst *Surface, rects *Rect, count int32, color uint32) bool {
-//	return sdlFillSurfaceRects(dst, rects, count, color)
-// }
-
 // [FlipSurface] flips a surface vertically or horizontally.
 //
 // [FlipSurface]: https://wiki.libsdl.org/SDL3/SDL_FlipSurface
@@ -217,6 +189,10 @@ func FlipSurface(surface *Surface, flip FlipMode) bool {
 //
 // [RotateSurface]: https://wiki.libsdl.org/SDL3/SDL_RotateSurface
 func RotateSurface(surface *Surface, angle float32) *Surface {
+	if sdlRotateSurface == nil {
+		notAvailable("SDL_RotateSurface")
+		return nil
+	}
 	return sdlRotateSurface(surface, angle)
 }
 
@@ -307,26 +283,6 @@ func MapSurfaceRGB(surface *Surface, r uint8, g uint8, b uint8) uint32 {
 	return sdlMapSurfaceRGB(surface, r, g, b)
 }
 
-// func MapSurfaceRGBA(surface *Surface, r uint8, g uint8, b uint8, a uint8) uint32 {
-//	return sdlMapSurfaceRGBA(surface, r, g, b, a)
-// }
-
-// func PremultiplyAlpha(width int32, height int32, src_format PixelFormat, src unsafe.Pointer, src_pitch int32, dst_format PixelFormat, dst unsafe.Pointer, dst_pitch int32, linear bool) bool {
-//	return sdlPremultiplyAlpha(width, height, src_format, src, src_pitch, dst_format, dst, dst_pitch, linear)
-// }
-
-// func PremultiplySurfaceAlpha(surface *Surface, linear bool) bool {
-//	return sdlPremultiplySurfaceAlpha(surface, linear)
-// }
-
-// func ReadSurfacePixel(surface *Surface, x int32, y int32, r *uint8, g *uint8, b *uint8, a *uint8) bool {
-//	return sdlReadSurfacePixel(surface, x, y, r, g, b, a)
-// }
-
-// func ReadSurfacePixelFloat(surface *Surface, x int32, y int32, r *float32, g *float32, b *float32, a *float32) bool {
-//	return sdlReadSurfacePixelFloat(surface, x, y, r, g, b, a)
-// }
-
 // [RemoveSurfaceAlternateImages] removes all alternate versions of a surface.
 //
 // [RemoveSurfaceAlternateImages]: https://wiki.libsdl.org/SDL3/SDL_RemoveSurfaceAlternateImages
@@ -436,64 +392,41 @@ func UnlockSurface(surface *Surface) {
 	sdlUnlockSurface(surface)
 }
 
-// func WriteSurfacePixel(surface *Surface, x int32, y int32, r uint8, g uint8, b uint8, a uint8) bool {
-//	return sdlWriteSurfacePixel(surface, x, y, r, g, b, a)
-// }
-
-// func WriteSurfacePixelFloat(surface *Surface, x int32, y int32, r float32, g float32, b float32, a float32) bool {
-//	return sdlWriteSurfacePixelFloat(surface, x, y, r, g, b, a)
-// }
-
-// [LoadSurfaceIO] loads a BMP or PNG image from a seekable SDL data stream.
-//
-// Available since SDL 3.4.0.
-//
-// [LoadSurfaceIO]: https://wiki.libsdl.org/SDL3/SDL_LoadSurface_IO
-// func LoadSurfaceIO(src *IOStream, closeio bool) *Surface {
-// 	return sdlLoadSurfaceIO(src, closeio)
-// }
-
 // [LoadSurface] loads a BMP or PNG image from a file.
 //
 // Available since SDL 3.4.0.
 //
 // [LoadSurface]: https://wiki.libsdl.org/SDL3/SDL_LoadSurface
 func LoadSurface(file string) *Surface {
+	if sdlLoadSurface == nil {
+		notAvailable("SDL_LoadSurface")
+		return nil
+	}
 	return sdlLoadSurface(file)
 }
 
-// [LoadPNGIO] loads a PNG image from a seekable SDL data stream.
-//
-// Available since SDL 3.4.0.
-//
-// [LoadPNGIO]: https://wiki.libsdl.org/SDL3/SDL_LoadPNG_IO
-// func LoadPNGIO(src *IOStream, closeio bool) *Surface {
-// 	return sdlLoadPNGIO(src, closeio)
-// }
-
 // [LoadPNG] loads a PNG image from a file.
 //
 // Available since SDL 3.4.0.
 //
 // [LoadPNG]: https://wiki.libsdl.org/SDL3/SDL_LoadPNG
 func LoadPNG(file string) *Surface {
+	if sdlLoadPNG == nil {
+		notAvailable("SDL_LoadPNG")
+		return nil
+	}
 	return sdlLoadPNG(file)
 }
 
-// [SavePNGIO] saves a surface to a seekable SDL data stream in PNG format.
-//
-// Available since SDL 3.4.0.
-//
-// [SavePNGIO]: https://wiki.libsdl.org/SDL3/SDL_SavePNG_IO
-// func SavePNGIO(surface *Surface, dst *IOStream, closeio bool) bool {
-// 	return sdlSavePNGIO(surface, dst, closeio)
-// }
-
 // [SavePNG] saves a surface to a file in PNG format.
 //
 // Available since SDL 3.4.0.
 //
 // [SavePNG]: https://wiki.libsdl.org/SDL3/SDL_SavePNG
 func SavePNG(surface *Surface, file string) bool {
+	if sdlSavePNG == nil {
+		notAvailable("SDL_SavePNG")
+		return false
+	}
 	return sdlSavePNG(surface, file)
 }
@@ -1,5 +1,7 @@
 package sdl
 
+import "unsafe"
+
 // [Thread] is the SDL thread object.
 //
 // [Thread]: https://wiki.libsdl.org/SDL3/SDL_Thread
@@ -44,50 +46,83 @@ const (
 // [ThreadFunction]: https://wiki.libsdl.org/SDL3/SDL_ThreadFunction
 type ThreadFunction uintptr
 
-// func CleanupTLS()  {
-//	sdlCleanupTLS()
-// }
-
-// func CreateThreadRuntime(fn ThreadFunction, name string, data unsafe.Pointer, pfnBeginThread FunctionPointer, pfnEndThread FunctionPointer) *Thread {
-//	return sdlCreateThreadRuntime(fn, name, data, pfnBeginThread, pfnEndThread)
-// }
+// [TLSDestructorCallback] is the type of the destructor passed to [SetTLS]. Use [TLS] for a Go destructor.
+//
+// [TLSDestructorCallback]: https://wiki.libsdl.org/SDL3/SDL_TLSDestructorCallback
+type TLSDestructorCallback uintptr
 
-// func CreateThreadWithPropertiesRuntime(props PropertiesID, pfnBeginThread FunctionPointer, pfnEndThread FunctionPointer) *Thread {
-//	return sdlCreateThreadWithPropertiesRuntime(props, pfnBeginThread, pfnEndThread)
-// }
+// [CleanupTLS] cleans up all TLS data for this thread. It must be called on threads not created by SDL that used [SetTLS], before they exit.
+//
+// [CleanupTLS]: https://wiki.libsdl.org/SDL3/SDL_CleanupTLS
+func CleanupTLS() {
+	sdlCleanupTLS()
+}
 
-// func DetachThread(thread *Thread)  {
-//	sdlDetachThread(thread)
-// }
+// [DetachThread] lets a thread clean up on exit without intervention. The thread must not be used afterwards.
+//
+// [DetachThread]: https://wiki.libsdl.org/SDL3/SDL_DetachThread
+func DetachThread(thread *Thread) {
+	sdlDetachThread(thread)
+}
 
-// func GetCurrentThreadID() ThreadID {
-//	return sdlGetCurrentThreadID()
-// }
+// [GetCurrentThreadID] gets the thread identifier for the current thread.
+//
+// [GetCurrentThreadID]: https://wiki.libsdl.org/SDL3/SDL_GetCurrentThreadID
+func GetCurrentThreadID() ThreadID {
+	return sdlGetCurrentThreadID()
+}
 
-// func GetThreadID(thread *Thread) ThreadID {
-//	return sdlGetThreadID(thread)
-// }
+// [GetThreadID] gets the thread identifier for the specified thread or the current thread, if thread is nil.
+//
+// [GetThreadID]: https://wiki.libsdl.org/SDL3/SDL_GetThreadID
+func GetThreadID(thread *Thread) ThreadID {
+	return sdlGetThreadID(thread)
+}
 
-// func GetThreadName(thread *Thread) string {
-//	return sdlGetThreadName(thread)
-// }
+// [GetThreadName] gets the name of a thread as it was specified when the thread was created.
+//
+// [GetThreadName]: https://wiki.libsdl.org/SDL3/SDL_GetThreadName
+func GetThreadName(thread *Thread) string {
+	return sdlGetThreadName(thread)
+}
 
-// func GetThreadState(thread *Thread) ThreadState {
-//	return sdlGetThreadState(thread)
-// }
+// [GetThreadState] gets the current state of a thread.
+//
+// [GetThreadState]: https://wiki.libsdl.org/SDL3/SDL_GetThreadState
+func GetThreadState(thread *Thread) ThreadState {
+	return sdlGetThreadState(thread)
+}
 
-// func GetTLS(id *TLSID) unsafe.Pointer {
-//	return sdlGetTLS(id)
-// }
+// [GetTLS] gets the current thread's value associated with a thread local storage ID.
+//
+// The goroutine must be locked to its thread with [runtime.LockOSThread], otherwise it may read the value of any
+// thread it happens to run on. [TLS] takes care of that.
+//
+// [GetTLS]: https://wiki.libsdl.org/SDL3/SDL_GetTLS
+func GetTLS(id *TLSID) unsafe.Pointer {
+	return sdlGetTLS(id)
+}
 
-// func SetCurrentThreadPriority(priority ThreadPriority) bool {
-//	return sdlSetCurrentThreadPriority(priority)
-// }
+// [SetCurrentThreadPriority] sets the priority for the current thread. Goroutines should use [LockThreadPriority] instead.
+//
+// [SetCurrentThreadPriority]: https://wiki.libsdl.org/SDL3/SDL_SetCurrentThreadPriority
+func SetCurrentThreadPriority(priority ThreadPriority) bool {
+	return sdlSetCurrentThreadPriority(priority)
+}
 
-// func SetTLS(id *TLSID, value unsafe.Pointer, destructor TLSDestructorCallback) bool {
-//	return sdlSetTLS(id, value, destructor)
-// }
+// [SetTLS] sets the current thread's value associated with a thread local storage ID. The value must not point to Go memory.
+//
+// The goroutine must be locked to its thread with [runtime.LockOSThread], otherwise the value is set on whichever
+// thread it happens to run on. [TLS] takes care of that and also accepts a Go function as destructor.
+//
+// [SetTLS]: https://wiki.libsdl.org/SDL3/SDL_SetTLS
+func SetTLS(id *TLSID, value unsafe.Pointer, destructor TLSDestructorCallback) bool {
+	return sdlSetTLS(id, value, destructor)
+}
 
-// func WaitThread(thread *Thread, status *int32)  {
-//	sdlWaitThread(thread, status)
-// }
+// [WaitThread] waits for a thread to finish and stores its return code in status, unless it is nil. The thread must not be used afterwards.
+//
+// [WaitThread]: https://wiki.libsdl.org/SDL3/SDL_WaitThread
+func WaitThread(thread *Thread, status *int32) {
+	sdlWaitThread(thread, status)
+}
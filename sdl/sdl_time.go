@@ -36,38 +36,51 @@ const (
 	TimeFormat12HR                   // 12 hour time.
 )
 
-// func DateTimeToTime(dt *DateTime, ticks *Time) bool {
-//	return sdlDateTimeToTime(dt, ticks)
-// }
-
-// func GetCurrentTime(ticks *Time) bool {
-//	return sdlGetCurrentTime(ticks)
-// }
-
-// func GetDateTimeLocalePreferences(dateFormat *DateFormat, timeFormat *TimeFormat) bool {
-//	return sdlGetDateTimeLocalePreferences(dateFormat, timeFormat)
-// }
+// [DateTimeToTime] converts a calendar time to a [Time] in nanoseconds since the epoch. The DayOfWeek field is ignored.
+//
+// [DateTimeToTime]: https://wiki.libsdl.org/SDL3/SDL_DateTimeToTime
+func DateTimeToTime(dt *DateTime, ticks *Time) bool {
+	return sdlDateTimeToTime(dt, ticks)
+}
 
-// func GetDayOfWeek(year int32, month int32, day int32) int32 {
-//	return sdlGetDayOfWeek(year, month, day)
-// }
+// [GetCurrentTime] gets the current value of the system realtime clock in nanoseconds since Jan 1, 1970 in Universal Coordinated Time (UTC).
+//
+// [GetCurrentTime]: https://wiki.libsdl.org/SDL3/SDL_GetCurrentTime
+func GetCurrentTime(ticks *Time) bool {
+	return sdlGetCurrentTime(ticks)
+}
 
-// func GetDayOfYear(year int32, month int32, day int32) int32 {
-//	return sdlGetDayOfYear(year, month, day)
-// }
+// [GetDateTimeLocalePreferences] gets the current preferred date and time format for the system locale. Either pointer may be nil.
+//
+// [GetDateTimeLocalePreferences]: https://wiki.libsdl.org/SDL3/SDL_GetDateTimeLocalePreferences
+func GetDateTimeLocalePreferences(dateFormat *DateFormat, timeFormat *TimeFormat) bool {
+	return sdlGetDateTimeLocalePreferences(dateFormat, timeFormat)
+}
 
-// func GetDaysInMonth(year int32, month int32) int32 {
-//	return sdlGetDaysInMonth(year, month)
-// }
+// [GetDayOfWeek] gets the day of week for a calendar date or -1 on failure.
+//
+// [GetDayOfWeek]: https://wiki.libsdl.org/SDL3/SDL_GetDayOfWeek
+func GetDayOfWeek(year int32, month int32, day int32) int32 {
+	return sdlGetDayOfWeek(year, month, day)
+}
 
-// func TimeFromWindows(dwLowDateTime uint32, dwHighDateTime uint32) Time {
-//	return sdlTimeFromWindows(dwLowDateTime, dwHighDateTime)
-// }
+// [GetDayOfYear] gets the day of year for a calendar date or -1 on failure.
+//
+// [GetDayOfYear]: https://wiki.libsdl.org/SDL3/SDL_GetDayOfYear
+func GetDayOfYear(year int32, month int32, day int32) int32 {
+	return sdlGetDayOfYear(year, month, day)
+}
 
-// func TimeToDateTime(ticks Time, dt *DateTime, localTime bool) bool {
-//	return sdlTimeToDateTime(ticks, dt, localTime)
-// }
+// [GetDaysInMonth] gets the number of days in a month for a given year or -1 on failure.
+//
+// [GetDaysInMonth]: https://wiki.libsdl.org/SDL3/SDL_GetDaysInMonth
+func GetDaysInMonth(year int32, month int32) int32 {
+	return sdlGetDaysInMonth(year, month)
+}
 
-// func TimeToWindows(ticks Time, dwLowDateTime *uint32, dwHighDateTime *uint32)  {
-//	sdlTimeToWindows(ticks, dwLowDateTime, dwHighDateTime)
-// }
+// [TimeToDateTime] converts a [Time] in nanoseconds since the epoch to a calendar time, in local time or UTC.
+//
+// [TimeToDateTime]: https://wiki.libsdl.org/SDL3/SDL_TimeToDateTime
+func TimeToDateTime(ticks Time, dt *DateTime, localTime bool) bool {
+	return sdlTimeToDateTime(ticks, dt, localTime)
+}
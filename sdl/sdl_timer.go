@@ -1,6 +1,10 @@
 package sdl
 
-import "github.com/ebitengine/purego"
+import (
+	"unsafe"
+
+	"github.com/ebitengine/purego"
+)
 
 // [TimerID] is a definition of the timer ID type.
 //
@@ -17,18 +21,28 @@ type TimerCallback uintptr
 // [NSTimerCallback]: https://wiki.libsdl.org/SDL3/SDL_NSTimerCallback
 type NSTimerCallback uintptr
 
-// func AddTimer(interval uint32, callback TimerCallback, userdata unsafe.Pointer) TimerID {
-//	return sdlAddTimer(interval, callback, userdata)
-// }
+// [AddTimer] calls a callback function at a future time and returns its ID or 0 on failure.
+// See [AddTimerFunc] for Go functions.
+//
+// [AddTimer]: https://wiki.libsdl.org/SDL3/SDL_AddTimer
+func AddTimer(interval uint32, callback TimerCallback, userdata unsafe.Pointer) TimerID {
+	return sdlAddTimer(interval, callback, userdata)
+}
 
-// func AddTimerNS(interval uint64, callback NSTimerCallback, userdata unsafe.Pointer) TimerID {
-//	return sdlAddTimerNS(interval, callback, userdata)
-// }
+// [AddTimerNS] calls a callback function at a future time and returns its ID or 0 on failure.
+// See [AddTimerFunc] for Go functions.
+//
+// [AddTimerNS]: https://wiki.libsdl.org/SDL3/SDL_AddTimerNS
+func AddTimerNS(interval uint64, callback NSTimerCallback, userdata unsafe.Pointer) TimerID {
+	return sdlAddTimerNS(interval, callback, userdata)
+}
 
-// Delay wait a specified number of milliseconds before returning.
-// func Delay(ms uint32)  {
-//	sdlDelay(ms)
-// }
+// [Delay] waits a specified number of milliseconds before returning.
+//
+// [Delay]: https://wiki.libsdl.org/SDL3/SDL_Delay
+func Delay(ms uint32) {
+	sdlDelay(ms)
+}
 
 // [DelayNS] waits a specified number of nanoseconds before returning.
 //
@@ -37,10 +51,13 @@ func DelayNS(ns uint64) {
 	sdlDelayNS(ns)
 }
 
-// DelayPrecise wait a specified number of nanoseconds before returning.
-// func DelayPrecise(ns uint64)  {
-//	sdlDelayPrecise(ns)
-// }
+// [DelayPrecise] waits a specified number of nanoseconds before returning. It busy-waits for the last part of
+// the delay, which makes it more precise than [DelayNS], but uses more CPU time.
+//
+// [DelayPrecise]: https://wiki.libsdl.org/SDL3/SDL_DelayPrecise
+func DelayPrecise(ns uint64) {
+	sdlDelayPrecise(ns)
+}
 
 // [GetPerformanceCounter] returns the current value of the high resolution counter.
 //
@@ -74,7 +91,9 @@ func GetTicksNS() uint64 {
 	return uint64(ret)
 }
 
-// RemoveTimer remove a timer created with SDL_AddTimer().
-// func RemoveTimer(id TimerID) bool {
-//	return sdlRemoveTimer(id)
-// }
+// [RemoveTimer] removes a timer created with [AddTimer] or [AddTimerNS] and reports whether it was still pending.
+//
+// [RemoveTimer]: https://wiki.libsdl.org/SDL3/SDL_RemoveTimer
+func RemoveTimer(id TimerID) bool {
+	return sdlRemoveTimer(id)
+}
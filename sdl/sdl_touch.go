@@ -31,19 +31,3 @@ type Finger struct {
 	Y        float32  // The y-axis location of the touch event, normalized (0...1).
 	Pressure float32  // The quantity of pressure applied, normalized (0...1).
 }
-
-// func GetTouchDeviceName(touchID TouchID) string {
-//	return sdlGetTouchDeviceName(touchID)
-// }
-
-// func GetTouchDevices(count *int32) *TouchID {
-//	return sdlGetTouchDevices(count)
-// }
-
-// func GetTouchDeviceType(touchID TouchID) TouchDeviceType {
-//	return sdlGetTouchDeviceType(touchID)
-// }
-
-// func GetTouchFingers(touchID TouchID, count *int32) **Finger {
-//	return sdlGetTouchFingers(touchID, count)
-// }
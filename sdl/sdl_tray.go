@@ -28,93 +28,10 @@ const (
 	TrayentryChecked  TrayEntryFlags = 0x40000000 // Make the entry checked. This is valid only for checkboxes. Optional.
 )
 
-// func ClickTrayEntry(entry *TrayEntry)  {
-//	sdlClickTrayEntry(entry)
-// }
-
-// func CreateTray(icon *Surface, tooltip string) *Tray {
-//	return sdlCreateTray(icon, tooltip)
-// }
-
-// func CreateTrayMenu(tray *Tray) *TrayMenu {
-//	return sdlCreateTrayMenu(tray)
-// }
-
-// func CreateTraySubmenu(entry *TrayEntry) *TrayMenu {
-//	return sdlCreateTraySubmenu(entry)
-// }
-
-// func DestroyTray(tray *Tray)  {
-//	sdlDestroyTray(tray)
-// }
-
-// func GetTrayEntries(menu *TrayMenu, size *int32) **TrayEntry {
-//	return sdlGetTrayEntries(menu, size)
-// }
-
-// func GetTrayEntryChecked(entry *TrayEntry) bool {
-//	return sdlGetTrayEntryChecked(entry)
-// }
-
-// func GetTrayEntryEnabled(entry *TrayEntry) bool {
-//	return sdlGetTrayEntryEnabled(entry)
-// }
-
-// func GetTrayEntryLabel(entry *TrayEntry) string {
-//	return sdlGetTrayEntryLabel(entry)
-// }
-
-// func GetTrayEntryParent(entry *TrayEntry) *TrayMenu {
-//	return sdlGetTrayEntryParent(entry)
-// }
-
-// func GetTrayMenu(tray *Tray) *TrayMenu {
-//	return sdlGetTrayMenu(tray)
-// }
-
-// func GetTrayMenuParentEntry(menu *TrayMenu) *TrayEntry {
-//	return sdlGetTrayMenuParentEntry(menu)
-// }
-
-// func GetTrayMenuParentTray(menu *TrayMenu) *Tray {
-//	return sdlGetTrayMenuParentTray(menu)
-// }
-
-// func GetTraySubmenu(entry *TrayEntry) *TrayMenu {
-//	return sdlGetTraySubmenu(entry)
-// }
-
-// func InsertTrayEntryAt(menu *TrayMenu, pos int32, label string, flags TrayEntryFlags) *TrayEntry {
-//	return sdlInsertTrayEntryAt(menu, pos, label, flags)
-// }
-
-// func RemoveTrayEntry(entry *TrayEntry)  {
-//	sdlRemoveTrayEntry(entry)
-// }
-
-// func SetTrayEntryCallback(entry *TrayEntry, callback TrayCallback, userdata unsafe.Pointer)  {
-//	sdlSetTrayEntryCallback(entry, callback, userdata)
-// }
-
-// func SetTrayEntryChecked(entry *TrayEntry, checked bool)  {
-//	sdlSetTrayEntryChecked(entry, checked)
-// }
-
-// func SetTrayEntryEnabled(entry *TrayEntry, enabled bool)  {
-//	sdlSetTrayEntryEnabled(entry, enabled)
-// }
-
-// func SetTrayEntryLabel(entry *TrayEntry, label string)  {
-//	sdlSetTrayEntryLabel(entry, label)
-// }
-
-// func SetTrayIcon(tray *Tray, icon *Surface)  {
-//	sdlSetTrayIcon(tray, icon)
-// }
-
-// func SetTrayTooltip(tray *Tray, tooltip string)  {
-//	sdlSetTrayTooltip(tray, tooltip)
-// }
+// [TrayCallback] is a callback to be invoked when a tray entry is selected.
+//
+// [TrayCallback]: https://wiki.libsdl.org/SDL3/SDL_TrayCallback
+type TrayCallback uintptr
 
 // [UpdateTrays] updates the trays.
 //
@@ -332,6 +332,31 @@ type GLContextState struct{}
 // [GLContext]: https://wiki.libsdl.org/SDL3/SDL_GLContext
 type GLContext *GLContextState
 
+// [EGLConfig] is an opaque type for an EGL config.
+//
+// [EGLConfig]: https://wiki.libsdl.org/SDL3/SDL_EGLConfig
+type EGLConfig unsafe.Pointer
+
+// [EGLDisplay] is an opaque type for an EGL display.
+//
+// [EGLDisplay]: https://wiki.libsdl.org/SDL3/SDL_EGLDisplay
+type EGLDisplay unsafe.Pointer
+
+// [EGLSurface] is an opaque type for an EGL surface.
+//
+// [EGLSurface]: https://wiki.libsdl.org/SDL3/SDL_EGLSurface
+type EGLSurface unsafe.Pointer
+
+// [EGLAttribArrayCallback] is an EGL platform attribute initialization callback.
+//
+// [EGLAttribArrayCallback]: https://wiki.libsdl.org/SDL3/SDL_EGLAttribArrayCallback
+type EGLAttribArrayCallback uintptr
+
+// [EGLIntArrayCallback] is an EGL surface/context attribute initialization callback.
+//
+// [EGLIntArrayCallback]: https://wiki.libsdl.org/SDL3/SDL_EGLIntArrayCallback
+type EGLIntArrayCallback uintptr
+
 func WindowPosCenteredDisplay(displayID DisplayID) uint32 {
 	return WindowPosCenteredMask | uint32(displayID)
 }
@@ -343,10 +368,6 @@ func DestroyWindow(window *Window) {
 	sdlDestroyWindow(window)
 }
 
-// func CreatePopupWindow(parent *Window, offset_x int32, offset_y int32, w int32, h int32, flags WindowFlags) *Window {
-//	return sdlCreatePopupWindow(parent, offset_x, offset_y, w, h, flags)
-// }
-
 // [CreateWindow] creates a window with the specified dimensions and flags.
 //
 // [CreateWindow]: https://wiki.libsdl.org/SDL3/SDL_CreateWindow
@@ -375,26 +396,6 @@ func DisableScreenSaver() bool {
 	return sdlDisableScreenSaver()
 }
 
-// func EGL_GetCurrentConfig() EGLConfig {
-//	return sdlEGL_GetCurrentConfig()
-// }
-
-// func EGL_GetCurrentDisplay() EGLDisplay {
-//	return sdlEGL_GetCurrentDisplay()
-// }
-
-// func EGL_GetProcAddress(proc string) FunctionPointer {
-//	return sdlEGL_GetProcAddress(proc)
-// }
-
-// func EGL_GetWindowSurface(window *Window) EGLSurface {
-//	return sdlEGL_GetWindowSurface(window)
-// }
-
-// func EGL_SetAttributeCallbacks(platformAttribCallback EGLAttribArrayCallback, surfaceAttribCallback EGLIntArrayCallback, contextAttribCallback EGLIntArrayCallback, userdata unsafe.Pointer)  {
-//	sdlEGL_SetAttributeCallbacks(platformAttribCallback, surfaceAttribCallback, contextAttribCallback, userdata)
-// }
-
 // [EnableScreenSaver] allows the screen to be blanked by a screen saver.
 //
 // [EnableScreenSaver]: https://wiki.libsdl.org/SDL3/SDL_EnableScreenSaver
@@ -415,6 +416,10 @@ func FlashWindow(window *Window, operation FlashOperation) bool {
 //
 // [SetWindowProgressState]: https://wiki.libsdl.org/SDL3/SDL_SetWindowProgressState
 func SetWindowProgressState(window *Window, state ProgressState) bool {
+	if sdlSetWindowProgressState == nil {
+		notAvailable("SDL_SetWindowProgressState")
+		return false
+	}
 	return sdlSetWindowProgressState(window, state)
 }
 
@@ -424,6 +429,10 @@ func SetWindowProgressState(window *Window, state ProgressState) bool {
 //
 // [GetWindowProgressState]: https://wiki.libsdl.org/SDL3/SDL_GetWindowProgressState
 func GetWindowProgressState(window *Window) ProgressState {
+	if sdlGetWindowProgressState == nil {
+		notAvailable("SDL_GetWindowProgressState")
+		return ProgressStateInvalid
+	}
 	return sdlGetWindowProgressState(window)
 }
 
@@ -433,6 +442,10 @@ func GetWindowProgressState(window *Window) ProgressState {
 //
 // [SetWindowProgressValue]: https://wiki.libsdl.org/SDL3/SDL_SetWindowProgressValue
 func SetWindowProgressValue(window *Window, value float32) bool {
+	if sdlSetWindowProgressValue == nil {
+		notAvailable("SDL_SetWindowProgressValue")
+		return false
+	}
 	return sdlSetWindowProgressValue(window, value)
 }
 
@@ -442,6 +455,10 @@ func SetWindowProgressValue(window *Window, value float32) bool {
 //
 // [GetWindowProgressValue]: https://wiki.libsdl.org/SDL3/SDL_GetWindowProgressValue
 func GetWindowProgressValue(window *Window) float32 {
+	if sdlGetWindowProgressValue == nil {
+		notAvailable("SDL_GetWindowProgressValue")
+		return -1
+	}
 	return sdlGetWindowProgressValue(window)
 }
 
@@ -647,10 +664,6 @@ func GetWindowFullscreenMode(window *Window) *DisplayMode {
 	return sdlGetWindowFullscreenMode(window)
 }
 
-// func GetWindowICCProfile(window *Window, size *uint64) unsafe.Pointer {
-//	return sdlGetWindowICCProfile(window, size)
-// }
-
 // [GetWindowID] returns the ID of the window on success or 0 on failure.
 //
 // [GetWindowID]: https://wiki.libsdl.org/SDL3/SDL_GetWindowID
@@ -801,10 +814,6 @@ func GLDestroyContext(context GLContext) bool {
 	return sdlGLDestroyContext(context)
 }
 
-// func GL_ExtensionSupported(extension string) bool {
-//	return sdlGL_ExtensionSupported(extension)
-// }
-
 // [GLGetAttribute] gets the actual value for an attribute from the current context.
 //
 // [GLGetAttribute]: https://wiki.libsdl.org/SDL3/SDL_GL_GetAttribute
@@ -826,26 +835,6 @@ func GLGetCurrentWindow() *Window {
 	return sdlGLGetCurrentWindow()
 }
 
-// func GL_GetProcAddress(proc string) FunctionPointer {
-//	return sdlGL_GetProcAddress(proc)
-// }
-
-// func GL_GetSwapInterval(interval *int32) bool {
-//	return sdlGL_GetSwapInterval(interval)
-// }
-
-// func GL_LoadLibrary(path string) bool {
-//	return sdlGL_LoadLibrary(path)
-// }
-
-// func GL_MakeCurrent(window *Window, context GLContext) bool {
-//	return sdlGL_MakeCurrent(window, context)
-// }
-
-// func GL_ResetAttributes()  {
-//	sdlGL_ResetAttributes()
-// }
-
 // [GLSetAttribute] sets the OpenGL attribute attr to value. The requested attributes should be set before creating an OpenGL window.
 //
 // You should use [GLGetAttribute] to check the values after creating the OpenGL context,
@@ -872,10 +861,6 @@ func GLSwapWindow(window *Window) bool {
 	return byte(ret) != 0
 }
 
-// func GL_UnloadLibrary()  {
-//	sdlGL_UnloadLibrary()
-// }
-
 // [HideWindow] hides a window.
 //
 // [HideWindow]: https://wiki.libsdl.org/SDL3/SDL_HideWindow
@@ -925,15 +910,6 @@ func SetWindowAlwaysOnTop(window *Window, onTop bool) bool {
 	return sdlSetWindowAlwaysOnTop(window, onTop)
 }
 
-// [SetWindowFillDocument] sets the window to fill the current document space (Emscripten only).
-//
-// Available since SDL 3.4.0.
-//
-// [SetWindowFillDocument]: https://wiki.libsdl.org/SDL3/SDL_SetWindowFillDocument
-// func SetWindowFillDocument(window *Window, fill bool) bool {
-// 	return sdlSetWindowFillDocument(window, fill)
-// }
-
 // [SetWindowAspectRatio] requests that the aspect ratio of a window's client area be set.
 //
 // [SetWindowAspectRatio]: https://wiki.libsdl.org/SDL3/SDL_SetWindowAspectRatio
@@ -1058,10 +1034,6 @@ func SetWindowResizable(window *Window, resizable bool) bool {
 	return sdlSetWindowResizable(window, resizable)
 }
 
-// func SetWindowShape(window *Window, shape *Surface) bool {
-//	return sdlSetWindowShape(window, shape)
-// }
-
 // [SetWindowSize] requests that the size of a window's client area be set.
 //
 // [SetWindowSize]: https://wiki.libsdl.org/SDL3/SDL_SetWindowSize
@@ -1111,10 +1083,6 @@ func UpdateWindowSurface(window *Window) bool {
 	return sdlUpdateWindowSurface(window)
 }
 
-// func UpdateWindowSurfaceRects(window *Window, rects *Rect, numrects int32) bool {
-//	return sdlUpdateWindowSurfaceRects(window, rects, numrects)
-// }
-
 // [WindowHasSurface] returns whether the window has a surface associated with it.
 //
 // [WindowHasSurface]: https://wiki.libsdl.org/SDL3/SDL_WindowHasSurface
@@ -0,0 +1,153 @@
+//go:build go1.21
+
+package sdl
+
+import (
+	"context"
+	"log/slog"
+	"strconv"
+	"strings"
+)
+
+// NewSlogHandler returns an [slog.Handler] that writes records to the SDL log with [LogMessage], e.g. to log
+// through the output function of the platform (logcat on Android) or one installed with [SetLogOutputFunc].
+//
+// Records are logged in category, unless they have an attribute "category" with a [LogCategory] value.
+// Their level is converted into a [LogPriority] and records below the priority of the category are dropped,
+// see [SetLogPriority]. The attributes are appended to the message as key=value pairs:
+//
+//	logger := slog.New(sdl.NewSlogHandler(sdl.LogCategoryApplication))
+//	logger.Warn("texture missing", "name", name, "category", sdl.LogCategoryRender)
+//
+// It must not be used by the logger passed to [SetLogOutputLogger], which would log every message forever.
+func NewSlogHandler(category LogCategory) slog.Handler {
+	return &slogHandler{category: category}
+}
+
+type slogHandler struct {
+	category LogCategory
+	prefix   string // group prefix for the keys of further attributes
+	attrs    string // formatted attributes added with WithAttrs
+}
+
+func (h *slogHandler) Enabled(_ context.Context, level slog.Level) bool {
+	return logPriority(level) >= GetLogPriority(h.category)
+}
+
+func (h *slogHandler) Handle(_ context.Context, r slog.Record) error {
+	category := h.category
+	var b strings.Builder
+	b.WriteString(r.Message)
+	b.WriteString(h.attrs)
+	r.Attrs(func(a slog.Attr) bool {
+		if c, ok := a.Value.Any().(LogCategory); ok && a.Key == "category" {
+			category = c
+			return true
+		}
+		appendAttr(&b, h.prefix, a)
+		return true
+	})
+	if priority := logPriority(r.Level); priority >= GetLogPriority(category) {
+		LogMessage(category, priority, "%s", b.String())
+	}
+	return nil
+}
+
+func (h *slogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	h2 := *h
+	var b strings.Builder
+	b.WriteString(h.attrs)
+	for _, a := range attrs {
+		if c, ok := a.Value.Any().(LogCategory); ok && a.Key == "category" {
+			h2.category = c
+			continue
+		}
+		appendAttr(&b, h.prefix, a)
+	}
+	h2.attrs = b.String()
+	return &h2
+}
+
+func (h *slogHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	h2 := *h
+	h2.prefix = h.prefix + name + "."
+	return &h2
+}
+
+// appendAttr appends " key=value" to b, flattening groups.
+func appendAttr(b *strings.Builder, prefix string, a slog.Attr) {
+	a.Value = a.Value.Resolve()
+	if a.Equal(slog.Attr{}) {
+		return
+	}
+	if a.Value.Kind() == slog.KindGroup {
+		if a.Key != "" {
+			prefix += a.Key + "."
+		}
+		for _, ga := range a.Value.Group() {
+			appendAttr(b, prefix, ga)
+		}
+		return
+	}
+	b.WriteByte(' ')
+	b.WriteString(prefix)
+	b.WriteString(a.Key)
+	b.WriteByte('=')
+	if s := a.Value.String(); a.Value.Kind() == slog.KindString && strings.ContainsAny(s, " =\"") {
+		b.WriteString(strconv.Quote(s))
+	} else {
+		b.WriteString(s)
+	}
+}
+
+// SetLogOutputLogger installs an output function with [SetLogOutputFunc] that writes all SDL log messages,
+// including the ones of SDL itself, to logger. The level is converted from the [LogPriority] and the category
+// is added as attribute "category", e.g. "video". Releasing the returned handle restores the previous output function.
+func SetLogOutputLogger(logger *slog.Logger) *CallbackHandle {
+	return SetLogOutputFunc(func(category LogCategory, priority LogPriority, message string) {
+		logger.LogAttrs(context.Background(), slogLevel(priority), message, slog.String("category", category.String()))
+	})
+}
+
+// logPriority converts a level of package slog into a [LogPriority].
+func logPriority(level slog.Level) LogPriority {
+	switch {
+	case level >= slog.LevelError+4:
+		return LogPriorityCritical
+	case level >= slog.LevelError:
+		return LogPriorityError
+	case level >= slog.LevelWarn:
+		return LogPriorityWarn
+	case level >= slog.LevelInfo:
+		return LogPriorityInfo
+	case level >= slog.LevelDebug:
+		return LogPriorityDebug
+	case level >= slog.LevelDebug-4:
+		return LogPriorityVerbose
+	default:
+		return LogPriorityTrace
+	}
+}
+
+// slogLevel converts a [LogPriority] into a level of package slog.
+func slogLevel(priority LogPriority) slog.Level {
+	switch priority {
+	case LogPriorityTrace:
+		return slog.LevelDebug - 8
+	case LogPriorityVerbose:
+		return slog.LevelDebug - 4
+	case LogPriorityDebug:
+		return slog.LevelDebug
+	case LogPriorityWarn:
+		return slog.LevelWarn
+	case LogPriorityError:
+		return slog.LevelError
+	case LogPriorityCritical:
+		return slog.LevelError + 4
+	default:
+		return slog.LevelInfo
+	}
+}
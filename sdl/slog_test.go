@@ -0,0 +1,55 @@
+//go:build go1.21
+
+package sdl
+
+import (
+	"log/slog"
+	"testing"
+)
+
+func TestSlogLevels(t *testing.T) {
+	tests := []struct {
+		level    slog.Level
+		priority LogPriority
+	}{
+		{slog.LevelDebug - 8, LogPriorityTrace},
+		{slog.LevelDebug - 4, LogPriorityVerbose},
+		{slog.LevelDebug, LogPriorityDebug},
+		{slog.LevelInfo, LogPriorityInfo},
+		{slog.LevelWarn, LogPriorityWarn},
+		{slog.LevelError, LogPriorityError},
+		{slog.LevelError + 4, LogPriorityCritical},
+	}
+	for _, tt := range tests {
+		if got := logPriority(tt.level); got != tt.priority {
+			t.Errorf("logPriority(%v) = %d, want %d", tt.level, got, tt.priority)
+		}
+		if got := slogLevel(tt.priority); got != tt.level {
+			t.Errorf("slogLevel(%d) = %v, want %v", tt.priority, got, tt.level)
+		}
+	}
+
+	// Levels between the ones of package slog are rounded down, beyond them clamped.
+	between := []struct {
+		level    slog.Level
+		priority LogPriority
+	}{
+		{slog.LevelDebug - 100, LogPriorityTrace},
+		{slog.LevelDebug - 5, LogPriorityTrace},
+		{slog.LevelDebug - 1, LogPriorityVerbose},
+		{slog.LevelInfo - 1, LogPriorityDebug},
+		{slog.LevelInfo + 1, LogPriorityInfo},
+		{slog.LevelError - 1, LogPriorityWarn},
+		{slog.LevelError + 3, LogPriorityError},
+		{slog.LevelError + 100, LogPriorityCritical},
+	}
+	for _, tt := range between {
+		if got := logPriority(tt.level); got != tt.priority {
+			t.Errorf("logPriority(%v) = %d, want %d", tt.level, got, tt.priority)
+		}
+	}
+
+	if got := slogLevel(LogPriorityInvalid); got != slog.LevelInfo {
+		t.Errorf("slogLevel(LogPriorityInvalid) = %v, want %v", got, slog.LevelInfo)
+	}
+}
@@ -0,0 +1,274 @@
+package sdl
+
+import (
+	"bytes"
+	"io"
+	"io/fs"
+	"path"
+	"runtime"
+	"sort"
+	"time"
+	"unsafe"
+)
+
+// A *Storage can be used with the functions of package io/fs, e.g. to read save games with [fs.ReadFile]
+// or to walk the title storage with [fs.WalkDir]. Like all storage functions, the methods fail until the storage is
+// ready, see [Storage.Ready]. Paths use slashes and are relative to the root of the storage, which is named ".".
+var (
+	_ fs.FS         = (*Storage)(nil)
+	_ fs.ReadDirFS  = (*Storage)(nil)
+	_ fs.ReadFileFS = (*Storage)(nil)
+	_ fs.StatFS     = (*Storage)(nil)
+)
+
+// Open implements [fs.FS]. Files are read into memory completely with [ReadStorageFile].
+func (storage *Storage) Open(name string) (fs.File, error) {
+	info, err := storage.stat("open", name)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return &storageDir{storage: storage, name: name, info: info}, nil
+	}
+	data, err := storage.readFile("open", name)
+	if err != nil {
+		return nil, err
+	}
+	return &storageFile{Reader: bytes.NewReader(data), info: info}, nil
+}
+
+// ReadFile implements [fs.ReadFileFS] using [GetStorageFileSize] and [ReadStorageFile].
+func (storage *Storage) ReadFile(name string) ([]byte, error) {
+	return storage.readFile("readfile", name)
+}
+
+// Stat implements [fs.StatFS] using [GetStoragePathInfo].
+func (storage *Storage) Stat(name string) (fs.FileInfo, error) {
+	return storage.stat("stat", name)
+}
+
+// ReadDir implements [fs.ReadDirFS] using [EnumerateStorageDirectory]. The entries are sorted by name.
+func (storage *Storage) ReadDir(name string) ([]fs.DirEntry, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	dir, err := storagePath("readdir", name)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	ok := EnumerateStorageDirectoryFunc(storage, dir, func(dirname, fname string) EnumerationResult {
+		names = append(names, fname)
+		return EnumContinue
+	})
+	if !ok {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: NewError("SDL_EnumerateStorageDirectory")}
+	}
+	sort.Strings(names)
+
+	entries := make([]fs.DirEntry, 0, len(names))
+	for _, fname := range names {
+		info, err := storage.stat("readdir", path.Join(name, fname))
+		if err != nil {
+			return entries, err
+		}
+		entries = append(entries, fs.FileInfoToDirEntry(info))
+	}
+	return entries, nil
+}
+
+// WriteFile writes data to the file name with [WriteStorageFile], replacing it if it exists.
+func (storage *Storage) WriteFile(name string, data []byte) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	file, err := storagePath("write", name)
+	if err != nil {
+		return err
+	}
+	var source unsafe.Pointer
+	if len(data) > 0 {
+		source = unsafe.Pointer(&data[0])
+	}
+	if !WriteStorageFile(storage, file, source, uint64(len(data))) {
+		return &fs.PathError{Op: "write", Path: name, Err: NewError("SDL_WriteStorageFile")}
+	}
+	return nil
+}
+
+// Mkdir creates the directory name with [CreateStorageDirectory].
+func (storage *Storage) Mkdir(name string) error {
+	return storage.do("mkdir", name, "SDL_CreateStorageDirectory", CreateStorageDirectory)
+}
+
+// Remove removes the file or empty directory name with [RemoveStoragePath].
+func (storage *Storage) Remove(name string) error {
+	return storage.do("remove", name, "SDL_RemoveStoragePath", RemoveStoragePath)
+}
+
+// Rename renames oldname to newname with [RenameStoragePath].
+func (storage *Storage) Rename(oldname, newname string) error {
+	return storage.do2("rename", oldname, newname, "SDL_RenameStoragePath", RenameStoragePath)
+}
+
+// CopyFile copies the file oldname to newname with [CopyStorageFile].
+func (storage *Storage) CopyFile(oldname, newname string) error {
+	return storage.do2("copy", oldname, newname, "SDL_CopyStorageFile", CopyStorageFile)
+}
+
+// SpaceRemaining returns the number of bytes that can still be written, see [GetStorageSpaceRemaining].
+func (storage *Storage) SpaceRemaining() uint64 {
+	return GetStorageSpaceRemaining(storage)
+}
+
+// Ready reports whether the storage can be accessed, see [StorageReady].
+func (storage *Storage) Ready() bool {
+	return StorageReady(storage)
+}
+
+// Close closes the storage with [CloseStorage]. The storage is freed, even if an error is returned.
+func (storage *Storage) Close() error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return Check("SDL_CloseStorage", CloseStorage(storage))
+}
+
+func (storage *Storage) stat(op, name string) (fs.FileInfo, error) {
+	file, err := storagePath(op, name)
+	if err != nil {
+		return nil, err
+	}
+	if file == "" {
+		return &pathFileInfo{name: ".", info: PathInfo{Type: PathTypeDirectory}}, nil
+	}
+	var info PathInfo
+	if !GetStoragePathInfo(storage, file, &info) || info.Type == PathTypeNone {
+		// SDL doesn't tell missing paths apart from other failures.
+		return nil, &fs.PathError{Op: op, Path: name, Err: fs.ErrNotExist}
+	}
+	return &pathFileInfo{name: path.Base(name), info: info}, nil
+}
+
+func (storage *Storage) readFile(op, name string) ([]byte, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	file, err := storagePath(op, name)
+	if err != nil {
+		return nil, err
+	}
+	var size uint64
+	if !GetStorageFileSize(storage, file, &size) {
+		return nil, &fs.PathError{Op: op, Path: name, Err: NewError("SDL_GetStorageFileSize")}
+	}
+	data := make([]byte, size)
+	var destination unsafe.Pointer
+	if size > 0 {
+		destination = unsafe.Pointer(&data[0])
+	}
+	if !ReadStorageFile(storage, file, destination, size) {
+		return nil, &fs.PathError{Op: op, Path: name, Err: NewError("SDL_ReadStorageFile")}
+	}
+	return data, nil
+}
+
+func (storage *Storage) do(op, name, fn string, f func(*Storage, string) bool) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	file, err := storagePath(op, name)
+	if err != nil {
+		return err
+	}
+	if !f(storage, file) {
+		return &fs.PathError{Op: op, Path: name, Err: NewError(fn)}
+	}
+	return nil
+}
+
+func (storage *Storage) do2(op, oldname, newname, fn string, f func(*Storage, string, string) bool) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	oldpath, err := storagePath(op, oldname)
+	if err != nil {
+		return err
+	}
+	newpath, err := storagePath(op, newname)
+	if err != nil {
+		return err
+	}
+	if !f(storage, oldpath, newpath) {
+		return &fs.PathError{Op: op, Path: oldname, Err: NewError(fn)}
+	}
+	return nil
+}
+
+// storagePath converts a path of package io/fs into a storage path. The root is the empty string.
+func storagePath(op, name string) (string, error) {
+	if !fs.ValidPath(name) {
+		return "", &fs.PathError{Op: op, Path: name, Err: fs.ErrInvalid}
+	}
+	if name == "." {
+		return "", nil
+	}
+	return name, nil
+}
+
+// pathFileInfo implements [fs.FileInfo] for a [PathInfo].
+type pathFileInfo struct {
+	name string
+	info PathInfo
+}
+
+func (fi *pathFileInfo) Name() string       { return fi.name }
+func (fi *pathFileInfo) Size() int64        { return int64(fi.info.Size) }
+func (fi *pathFileInfo) Mode() fs.FileMode  { return fi.info.Mode() }
+func (fi *pathFileInfo) ModTime() time.Time { return fi.info.Modified() }
+func (fi *pathFileInfo) IsDir() bool        { return fi.info.Type == PathTypeDirectory }
+func (fi *pathFileInfo) Sys() interface{}   { return &fi.info }
+
+// storageFile is a file read into memory.
+type storageFile struct {
+	*bytes.Reader
+	info fs.FileInfo
+}
+
+func (f *storageFile) Stat() (fs.FileInfo, error) { return f.info, nil }
+func (f *storageFile) Close() error               { return nil }
+
+// storageDir is an open directory, which is enumerated on the first call of ReadDir.
+type storageDir struct {
+	storage *Storage
+	name    string
+	info    fs.FileInfo
+	entries []fs.DirEntry
+	read    bool
+}
+
+func (d *storageDir) Stat() (fs.FileInfo, error) { return d.info, nil }
+func (d *storageDir) Close() error               { return nil }
+
+func (d *storageDir) Read([]byte) (int, error) {
+	return 0, &fs.PathError{Op: "read", Path: d.name, Err: fs.ErrInvalid}
+}
+
+func (d *storageDir) ReadDir(n int) ([]fs.DirEntry, error) {
+	if !d.read {
+		entries, err := d.storage.ReadDir(d.name)
+		if err != nil {
+			return nil, err
+		}
+		d.entries, d.read = entries, true
+	}
+	if n <= 0 {
+		entries := d.entries
+		d.entries = nil
+		return entries, nil
+	}
+	if len(d.entries) == 0 {
+		return nil, io.EOF
+	}
+	if n > len(d.entries) {
+		n = len(d.entries)
+	}
+	entries := d.entries[:n]
+	d.entries = d.entries[n:]
+	return entries, nil
+}
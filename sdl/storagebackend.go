@@ -0,0 +1,225 @@
+package sdl
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"runtime"
+	"unsafe"
+
+	"github.com/ebitengine/purego"
+	"github.com/jupiterrider/purego-sdl3/internal/convert"
+)
+
+// StorageBackend implements a storage container in Go, e.g. in memory for tests or on top of an encrypted file.
+// See [OpenStorageBackend].
+//
+// Paths are relative to the root of the storage, separated by slashes, and already validated by SDL.
+// The root itself is the empty string. Methods return an error for unsupported operations, e.g. [fs.ErrPermission]
+// for writes to read-only storage. Their messages are passed to SDL and reported by [GetError].
+// They may be called from any thread, but SDL doesn't call them concurrently for the same storage.
+type StorageBackend interface {
+	// Ready reports whether the storage is currently ready for access.
+	Ready() bool
+	// Enumerate calls callback for each entry of the directory path, until it returns something else than
+	// [EnumContinue]. The dirname passed to callback is path with a trailing slash, unless path is empty.
+	Enumerate(path string, callback func(dirname, fname string) EnumerationResult) error
+	// Info returns information about path.
+	Info(path string) (PathInfo, error)
+	// ReadFile reads the file path into dst, which has the size reported by Info.
+	ReadFile(path string, dst []byte) error
+	// WriteFile creates or replaces the file path.
+	WriteFile(path string, data []byte) error
+	// Mkdir creates the directory path.
+	Mkdir(path string) error
+	// Remove removes a file or an empty directory.
+	Remove(path string) error
+	// Rename renames a file or directory.
+	Rename(oldpath, newpath string) error
+	// Copy copies a file.
+	Copy(oldpath, newpath string) error
+	// SpaceRemaining returns the number of bytes that can still be written.
+	SpaceRemaining() uint64
+}
+
+// OpenStorageBackend opens a storage container implemented by backend with [OpenStorage] or returns nil on failure.
+// [CloseStorage] closes the backend, if it implements [io.Closer].
+func OpenStorageBackend(backend StorageBackend) *Storage {
+	h := newCallbackHandle(backend, nil)
+	iface := storageBackendInterface()
+	storage := sdlOpenStorage(&iface, h.pointer())
+	if storage == nil {
+		h.Release()
+	}
+	return storage
+}
+
+// OpenFSStorage opens a read-only storage container for fsys, e.g. a zip archive, using [OpenStorageBackend].
+func OpenFSStorage(fsys fs.FS) *Storage {
+	return OpenStorageBackend(fsStorage{fsys})
+}
+
+var storageBackendTrampolines struct {
+	close, ready, enumerate, info, readFile, writeFile, mkdir, remove, rename, copy, spaceRemaining trampoline
+}
+
+// storageBackendInterface returns the interface shared by all containers opened by [OpenStorageBackend].
+func storageBackendInterface() StorageInterface {
+	t := &storageBackendTrampolines
+	return StorageInterface{
+		Version: uint32(unsafe.Sizeof(StorageInterface{})),
+		Close: t.close.get(func(userdata uintptr) uintptr {
+			backend := lookupStorageBackend(userdata)
+			releaseCallback(userdata)
+			if c, ok := backend.(io.Closer); ok {
+				return storageResult(c.Close())
+			}
+			return 1
+		}),
+		Ready: t.ready.get(func(userdata uintptr) uintptr {
+			if lookupStorageBackend(userdata).Ready() {
+				return 1
+			}
+			return 0
+		}),
+		Enumerate: t.enumerate.get(func(userdata uintptr, dir *byte, callback EnumerateDirectoryCallback, callbackUserdata unsafe.Pointer) uintptr {
+			result := EnumContinue
+			err := lookupStorageBackend(userdata).Enumerate(convert.ToString(dir), func(dirname, fname string) EnumerationResult {
+				cDirname, cFname := convert.ToBytePtr(dirname), convert.ToBytePtr(fname)
+				r, _, _ := purego.SyscallN(uintptr(callback), uintptr(callbackUserdata),
+					uintptr(unsafe.Pointer(cDirname)), uintptr(unsafe.Pointer(cFname)))
+				runtime.KeepAlive(cDirname)
+				runtime.KeepAlive(cFname)
+				result = EnumerationResult(r)
+				return result
+			})
+			if err == nil && result == EnumFailure {
+				err = errors.New("enumeration callback failed")
+			}
+			return storageResult(err)
+		}),
+		Info: t.info.get(func(userdata uintptr, file *byte, info *PathInfo) uintptr {
+			i, err := lookupStorageBackend(userdata).Info(convert.ToString(file))
+			*info = i
+			return storageResult(err)
+		}),
+		ReadFile: t.readFile.get(func(userdata uintptr, file *byte, destination unsafe.Pointer, length uint64) uintptr {
+			dst := unsafe.Slice((*byte)(destination), length)
+			return storageResult(lookupStorageBackend(userdata).ReadFile(convert.ToString(file), dst))
+		}),
+		WriteFile: t.writeFile.get(func(userdata uintptr, file *byte, source unsafe.Pointer, length uint64) uintptr {
+			data := unsafe.Slice((*byte)(source), length)
+			return storageResult(lookupStorageBackend(userdata).WriteFile(convert.ToString(file), data))
+		}),
+		Mkdir: t.mkdir.get(func(userdata uintptr, dir *byte) uintptr {
+			return storageResult(lookupStorageBackend(userdata).Mkdir(convert.ToString(dir)))
+		}),
+		Remove: t.remove.get(func(userdata uintptr, file *byte) uintptr {
+			return storageResult(lookupStorageBackend(userdata).Remove(convert.ToString(file)))
+		}),
+		Rename: t.rename.get(func(userdata uintptr, oldpath, newpath *byte) uintptr {
+			return storageResult(lookupStorageBackend(userdata).Rename(convert.ToString(oldpath), convert.ToString(newpath)))
+		}),
+		Copy: t.copy.get(func(userdata uintptr, oldpath, newpath *byte) uintptr {
+			return storageResult(lookupStorageBackend(userdata).Copy(convert.ToString(oldpath), convert.ToString(newpath)))
+		}),
+		SpaceRemaining: t.spaceRemaining.get(func(userdata uintptr) uint64 {
+			return lookupStorageBackend(userdata).SpaceRemaining()
+		}),
+	}
+}
+
+func lookupStorageBackend(userdata uintptr) StorageBackend {
+	backend, _ := lookupCallback(userdata).(StorageBackend)
+	return backend
+}
+
+// storageResult converts the result of a backend method for SDL.
+func storageResult(err error) uintptr {
+	if err != nil {
+		setGoError(err)
+		return 0
+	}
+	return 1
+}
+
+// fsStorage is the backend of [OpenFSStorage].
+type fsStorage struct {
+	fsys fs.FS
+}
+
+func (s fsStorage) Ready() bool {
+	return true
+}
+
+func (s fsStorage) Enumerate(dir string, callback func(dirname, fname string) EnumerationResult) error {
+	name, dirname := ".", ""
+	if dir != "" {
+		name, dirname = dir, dir+"/"
+	}
+	entries, err := fs.ReadDir(s.fsys, name)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if callback(dirname, entry.Name()) != EnumContinue {
+			break
+		}
+	}
+	return nil
+}
+
+func (s fsStorage) Info(file string) (PathInfo, error) {
+	if file == "" {
+		file = "."
+	}
+	fi, err := fs.Stat(s.fsys, file)
+	if err != nil {
+		return PathInfo{}, err
+	}
+	info := PathInfo{Type: PathTypeFile, Size: uint64(fi.Size()), ModifyTime: TimeFromGo(fi.ModTime())}
+	info.CreateTime, info.AccessTime = info.ModifyTime, info.ModifyTime
+	switch {
+	case fi.IsDir():
+		info.Type, info.Size = PathTypeDirectory, 0
+	case !fi.Mode().IsRegular():
+		info.Type = PathTypeOther
+	}
+	return info, nil
+}
+
+func (s fsStorage) ReadFile(file string, dst []byte) error {
+	data, err := fs.ReadFile(s.fsys, file)
+	if err != nil {
+		return err
+	}
+	if len(data) != len(dst) {
+		return &fs.PathError{Op: "read", Path: file, Err: errors.New("file size changed")}
+	}
+	copy(dst, data)
+	return nil
+}
+
+func (s fsStorage) WriteFile(file string, data []byte) error {
+	return &fs.PathError{Op: "write", Path: file, Err: fs.ErrPermission}
+}
+
+func (s fsStorage) Mkdir(dir string) error {
+	return &fs.PathError{Op: "mkdir", Path: dir, Err: fs.ErrPermission}
+}
+
+func (s fsStorage) Remove(file string) error {
+	return &fs.PathError{Op: "remove", Path: file, Err: fs.ErrPermission}
+}
+
+func (s fsStorage) Rename(oldpath, newpath string) error {
+	return &fs.PathError{Op: "rename", Path: oldpath, Err: fs.ErrPermission}
+}
+
+func (s fsStorage) Copy(oldpath, newpath string) error {
+	return &fs.PathError{Op: "copy", Path: oldpath, Err: fs.ErrPermission}
+}
+
+func (s fsStorage) SpaceRemaining() uint64 {
+	return 0
+}
@@ -0,0 +1,100 @@
+package sdl
+
+import (
+	"runtime"
+	"unsafe"
+)
+
+// LockThreadPriority locks the calling goroutine to its current thread with [runtime.LockOSThread] and sets the
+// priority of the thread with [SetCurrentThreadPriority], e.g. for a goroutine producing audio:
+//
+//	go func() {
+//		if err := sdl.LockThreadPriority(sdl.ThreadPriorityTimeCritical); err != nil {
+//			log.Print(err)
+//		}
+//		for {
+//			// mix audio
+//		}
+//	}()
+//
+// The goroutine stays locked, so the thread exits together with the goroutine and its priority doesn't
+// apply to other goroutines. Raising the priority may require privileges.
+func LockThreadPriority(priority ThreadPriority) error {
+	runtime.LockOSThread()
+	return Check("SDL_SetCurrentThreadPriority", SetCurrentThreadPriority(priority))
+}
+
+// TLS is a thread local storage slot with a Go destructor, built on [SetTLS] and [GetTLS]:
+//
+//	var scratch sdl.TLS
+//
+//	go func() {
+//		defer sdl.CleanupTLS()
+//		if err := scratch.Set(sdl.Malloc(1024), sdl.Free); err != nil {
+//			log.Print(err)
+//		}
+//		// use scratch.Get()
+//	}()
+//
+// Set locks the calling goroutine to its current thread with [runtime.LockOSThread], so the value stays with
+// the goroutine. It stays locked, like with [LockThreadPriority]. The destructors run when SDL cleans up the thread,
+// which must be done with [CleanupTLS] before the goroutine exits. A TLS must not be copied after first use.
+type TLS struct {
+	id TLSID
+}
+
+// tlsRecord is the C memory stored by [TLS.Set]. It pairs the value with the handle of its destructor.
+type tlsRecord struct {
+	value  unsafe.Pointer
+	handle uintptr
+}
+
+var tlsDestructorTrampoline trampoline
+
+// Set sets the value of the calling goroutine. The destructor is called with the value when the thread is cleaned up,
+// unless it is nil. Like with [SetTLS], the destructor of a replaced value isn't called. The value must not
+// point to Go memory.
+func (t *TLS) Set(value unsafe.Pointer, destructor func(value unsafe.Pointer)) error {
+	runtime.LockOSThread()
+	record := (*tlsRecord)(Malloc(uint64(unsafe.Sizeof(tlsRecord{}))))
+	if record == nil {
+		return NewError("SDL_malloc")
+	}
+	record.value = value
+	if destructor != nil {
+		record.handle = newCallbackHandle(destructor, nil).userdata()
+	}
+	old := (*tlsRecord)(GetTLS(&t.id))
+	callback := TLSDestructorCallback(tlsDestructorTrampoline.get(func(record *tlsRecord) uintptr {
+		if fn, ok := lookupCallback(record.handle).(func(unsafe.Pointer)); ok {
+			fn(record.value)
+		}
+		freeTLSRecord(record)
+		return 0
+	}))
+	if !SetTLS(&t.id, unsafe.Pointer(record), callback) {
+		freeTLSRecord(record)
+		return NewError("SDL_SetTLS")
+	}
+	if old != nil {
+		freeTLSRecord(old)
+	}
+	return nil
+}
+
+// Get returns the value of the calling goroutine or nil, if it isn't set. The goroutine must have been locked
+// to its thread by [TLS.Set] or [runtime.LockOSThread].
+func (t *TLS) Get() unsafe.Pointer {
+	record := (*tlsRecord)(GetTLS(&t.id))
+	if record == nil {
+		return nil
+	}
+	return record.value
+}
+
+func freeTLSRecord(record *tlsRecord) {
+	if record.handle != 0 {
+		releaseCallback(record.handle)
+	}
+	Free(unsafe.Pointer(record))
+}
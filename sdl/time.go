@@ -0,0 +1,113 @@
+package sdl
+
+import (
+	"runtime"
+	"time"
+)
+
+// GoTime converts t into a [time.Time] in the local time zone.
+func (t Time) GoTime() time.Time {
+	return time.Unix(0, int64(t))
+}
+
+// TimeFromGo converts t into a [Time]. Times outside of the years 1678 to 2262 are clamped to [MinTime] or [MaxTime].
+func TimeFromGo(t time.Time) Time {
+	switch {
+	case t.Before(MinTime.GoTime()):
+		return MinTime
+	case t.After(MaxTime.GoTime()):
+		return MaxTime
+	}
+	return Time(t.UnixNano())
+}
+
+// Now returns the current time with [GetCurrentTime].
+func Now() (Time, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	var t Time
+	if !GetCurrentTime(&t) {
+		return 0, NewError("SDL_GetCurrentTime")
+	}
+	return t, nil
+}
+
+// GoTime converts dt into a [time.Time] in a fixed time zone with the offset UtcOffset.
+// The DayOfWeek field is ignored.
+func (dt *DateTime) GoTime() time.Time {
+	var loc *time.Location
+	if dt.UtcOffset == 0 {
+		loc = time.UTC
+	} else {
+		loc = time.FixedZone("", int(dt.UtcOffset))
+	}
+	return time.Date(int(dt.Year), time.Month(dt.Month), int(dt.Day), int(dt.Hour), int(dt.Minute), int(dt.Second), int(dt.Nanosecond), loc)
+}
+
+// DateTimeFromGo breaks t down into a [DateTime] in the time zone of t.
+func DateTimeFromGo(t time.Time) DateTime {
+	_, offset := t.Zone()
+	return DateTime{
+		Year:       int32(t.Year()),
+		Month:      int32(t.Month()),
+		Day:        int32(t.Day()),
+		Hour:       int32(t.Hour()),
+		Minute:     int32(t.Minute()),
+		Second:     int32(t.Second()),
+		Nanosecond: int32(t.Nanosecond()),
+		DayOfWeek:  int32(t.Weekday()),
+		UtcOffset:  int32(offset),
+	}
+}
+
+// LocaleFormat is the date and time format preferred by the user, see [GetLocaleFormat].
+//
+//	format, err := sdl.GetLocaleFormat()
+//	label := format.Format(slot.SavedAt) // e.g. "31/12/2025 23:59" or "12/31/2025 11:59 PM"
+type LocaleFormat struct {
+	Date DateFormat
+	Time TimeFormat
+}
+
+// GetLocaleFormat returns the preferred format of the system locale with [GetDateTimeLocalePreferences].
+// It may change while the application runs, which is reported by [EventLocaleChanged].
+func GetLocaleFormat() (LocaleFormat, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	var f LocaleFormat
+	if !GetDateTimeLocalePreferences(&f.Date, &f.Time) {
+		return LocaleFormat{}, NewError("SDL_GetDateTimeLocalePreferences")
+	}
+	return f, nil
+}
+
+// DateLayout returns the date layout for [time.Time.Format]: "2006-01-02", "02/01/2006" or "01/02/2006".
+func (f LocaleFormat) DateLayout() string {
+	switch f.Date {
+	case DateFormatDDMMYYYY:
+		return "02/01/2006"
+	case DateFormatMMDDYYYY:
+		return "01/02/2006"
+	default:
+		return "2006-01-02"
+	}
+}
+
+// TimeLayout returns the time layout for [time.Time.Format]: "15:04" or "3:04 PM", with seconds if requested.
+func (f LocaleFormat) TimeLayout(seconds bool) string {
+	switch {
+	case f.Time == TimeFormat12HR && seconds:
+		return "3:04:05 PM"
+	case f.Time == TimeFormat12HR:
+		return "3:04 PM"
+	case seconds:
+		return "15:04:05"
+	default:
+		return "15:04"
+	}
+}
+
+// Format formats the date and time of t without seconds.
+func (f LocaleFormat) Format(t time.Time) string {
+	return t.Format(f.DateLayout() + " " + f.TimeLayout(false))
+}
@@ -0,0 +1,90 @@
+package sdl
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimeFromGo(t *testing.T) {
+	tests := []struct {
+		name string
+		t    time.Time
+		want Time
+	}{
+		{"epoch", time.Unix(0, 0), 0},
+		{"nanoseconds", time.Unix(1, 5), 1000000005},
+		{"before epoch", time.Unix(-1, 0), -1000000000},
+		{"min", MinTime.GoTime(), MinTime},
+		{"max", MaxTime.GoTime(), MaxTime},
+		{"before min", time.Date(1600, 1, 1, 0, 0, 0, 0, time.UTC), MinTime},
+		{"after max", time.Date(2300, 1, 1, 0, 0, 0, 0, time.UTC), MaxTime},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TimeFromGo(tt.t); got != tt.want {
+				t.Errorf("got %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDateTimeGoTime(t *testing.T) {
+	tests := []struct {
+		name string
+		t    time.Time
+		want DateTime
+	}{
+		{
+			"UTC",
+			time.Date(2025, 12, 31, 23, 59, 58, 7, time.UTC),
+			DateTime{Year: 2025, Month: 12, Day: 31, Hour: 23, Minute: 59, Second: 58, Nanosecond: 7, DayOfWeek: 3},
+		},
+		{
+			"east of UTC",
+			time.Date(2024, 2, 29, 1, 2, 3, 0, time.FixedZone("", 5*3600+1800)),
+			DateTime{Year: 2024, Month: 2, Day: 29, Hour: 1, Minute: 2, Second: 3, DayOfWeek: 4, UtcOffset: 5*3600 + 1800},
+		},
+		{
+			"west of UTC",
+			time.Date(1999, 1, 1, 12, 0, 0, 0, time.FixedZone("", -8*3600)),
+			DateTime{Year: 1999, Month: 1, Day: 1, Hour: 12, DayOfWeek: 5, UtcOffset: -8 * 3600},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dt := DateTimeFromGo(tt.t)
+			if dt != tt.want {
+				t.Errorf("DateTimeFromGo: got %+v, want %+v", dt, tt.want)
+			}
+			got := dt.GoTime()
+			if !got.Equal(tt.t) {
+				t.Errorf("GoTime: got %v, want %v", got, tt.t)
+			}
+			if _, offset := got.Zone(); offset != int(tt.want.UtcOffset) {
+				t.Errorf("GoTime: got offset %d, want %d", offset, tt.want.UtcOffset)
+			}
+		})
+	}
+}
+
+func TestLocaleFormat(t *testing.T) {
+	at := time.Date(2025, 12, 31, 23, 59, 58, 0, time.UTC)
+	tests := []struct {
+		format      LocaleFormat
+		want        string
+		wantSeconds string
+	}{
+		{LocaleFormat{DateFormatYYYYMMDD, TimeFormat24HR}, "2025-12-31 23:59", "23:59:58"},
+		{LocaleFormat{DateFormatDDMMYYYY, TimeFormat24HR}, "31/12/2025 23:59", "23:59:58"},
+		{LocaleFormat{DateFormatMMDDYYYY, TimeFormat12HR}, "12/31/2025 11:59 PM", "11:59:58 PM"},
+		{LocaleFormat{DateFormat(99), TimeFormat12HR}, "2025-12-31 11:59 PM", "11:59:58 PM"},
+	}
+	for _, tt := range tests {
+		if got := tt.format.Format(at); got != tt.want {
+			t.Errorf("%+v: Format got %q, want %q", tt.format, got, tt.want)
+		}
+		if got := at.Format(tt.format.TimeLayout(true)); got != tt.wantSeconds {
+			t.Errorf("%+v: TimeLayout(true) got %q, want %q", tt.format, got, tt.wantSeconds)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package sdl
+
+import (
+	"runtime"
+	"time"
+	"unsafe"
+)
+
+// Timer is a timer started by [AddTimerFunc] or [AddTimerEvent].
+type Timer struct {
+	id     TimerID
+	handle *CallbackHandle
+}
+
+// timerFunc is the Go function of a [Timer].
+type timerFunc func(interval time.Duration) time.Duration
+
+// AddTimerFunc calls callback after interval with [AddTimerNS]. The callback receives the current interval and
+// returns the next one, or 0 to stop the timer:
+//
+//	timer, err := sdl.AddTimerFunc(time.Second, func(interval time.Duration) time.Duration {
+//		fmt.Println("tick")
+//		return interval
+//	})
+//	// ...
+//	timer.Stop()
+//
+// The callback runs on a separate thread, see [AddTimerEvent] for handling the timer in the main loop.
+func AddTimerFunc(interval time.Duration, callback func(interval time.Duration) time.Duration) (*Timer, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	t := &Timer{handle: newCallbackHandle(timerFunc(callback), nil)}
+	t.id = sdlAddTimerNS(uint64(interval), timerCallbackFunc(), t.handle.pointer())
+	if t.id == 0 {
+		err := NewError("SDL_AddTimerNS")
+		t.handle.Release()
+		return nil, err
+	}
+	return t, nil
+}
+
+// AddTimerEvent pushes a [UserEvent] of eventType with code onto the event queue every interval, until the timer
+// is stopped. The eventType should be allocated with [RegisterEvents]:
+//
+//	tick := sdl.EventType(sdl.RegisterEvents(1))
+//	timer, err := sdl.AddTimerEvent(time.Second/2, tick, 0)
+//	// ...
+//	for sdl.PollEvent(&event) {
+//		if event.Type() == tick {
+//			// runs on the main thread
+//		}
+//	}
+//
+// Ticks that can't be pushed, e.g. because the queue is full, are dropped.
+func AddTimerEvent(interval time.Duration, eventType EventType, code int32) (*Timer, error) {
+	return AddTimerFunc(interval, func(interval time.Duration) time.Duration {
+		var event Event
+		*(*UserEvent)(unsafe.Pointer(&event)) = UserEvent{CommonEvent: CommonEvent{Type: eventType}, Code: code}
+		PushEvent(&event)
+		return interval
+	})
+}
+
+// ID returns the ID of the timer, e.g. to tell timers with the same callback apart.
+func (t *Timer) ID() TimerID {
+	return t.id
+}
+
+// Stop removes the timer with [RemoveTimer] and reports whether it was still pending.
+// A callback running concurrently completes, but isn't called again.
+func (t *Timer) Stop() bool {
+	removed := RemoveTimer(t.id)
+	t.handle.Release()
+	return removed
+}
+
+var timerTrampoline trampoline
+
+func timerCallbackFunc() NSTimerCallback {
+	return NSTimerCallback(timerTrampoline.get(func(userdata uintptr, timerID TimerID, interval uint64) uint64 {
+		callback, ok := lookupCallback(userdata).(timerFunc)
+		if !ok {
+			return 0
+		}
+		next := callback(time.Duration(interval))
+		if next <= 0 {
+			releaseCallback(userdata)
+			return 0
+		}
+		return uint64(next)
+	}))
+}
@@ -0,0 +1,2166 @@
+// Code generated by bindgen from sdl3.json. DO NOT EDIT.
+
+package sdl
+
+import (
+	"unsafe"
+)
+
+// [AddGamepadMapping] adds support for gamepads that SDL is unaware of or changes the binding of an existing gamepad.
+//
+// [AddGamepadMapping]: https://wiki.libsdl.org/SDL3/SDL_AddGamepadMapping
+func AddGamepadMapping(mapping string) int32 {
+	return sdlAddGamepadMapping(mapping)
+}
+
+// [AddGamepadMappingsFromFile] loads a set of gamepad mappings from a file.
+//
+// [AddGamepadMappingsFromFile]: https://wiki.libsdl.org/SDL3/SDL_AddGamepadMappingsFromFile
+func AddGamepadMappingsFromFile(file string) int32 {
+	return sdlAddGamepadMappingsFromFile(file)
+}
+
+// [AddGamepadMappingsFromIO] loads a set of gamepad mappings from an [IOStream].
+//
+// [AddGamepadMappingsFromIO]: https://wiki.libsdl.org/SDL3/SDL_AddGamepadMappingsFromIO
+func AddGamepadMappingsFromIO(src *IOStream, closeio bool) int32 {
+	return sdlAddGamepadMappingsFromIO(src, closeio)
+}
+
+// [AttachVirtualJoystick] attaches a new virtual joystick.
+//
+// [AttachVirtualJoystick]: https://wiki.libsdl.org/SDL3/SDL_AttachVirtualJoystick
+func AttachVirtualJoystick(desc *VirtualJoystickDesc) JoystickID {
+	return sdlAttachVirtualJoystick(desc)
+}
+
+// [AudioDevicePaused] uses this function to query if an audio device is paused.
+//
+// [AudioDevicePaused]: https://wiki.libsdl.org/SDL3/SDL_AudioDevicePaused
+func AudioDevicePaused(dev AudioDeviceID) bool {
+	return sdlAudioDevicePaused(dev)
+}
+
+// [BeginGPUComputePass] begins a compute pass on a command buffer.
+//
+// [BeginGPUComputePass]: https://wiki.libsdl.org/SDL3/SDL_BeginGPUComputePass
+func BeginGPUComputePass(commandBuffer *GPUCommandBuffer, storageTextureBindings *GPUStorageTextureReadWriteBinding, numStorageTextureBindings uint32, storageBufferBindings *GPUStorageBufferReadWriteBinding, numStorageBufferBindings uint32) *GPUComputePass {
+	return sdlBeginGPUComputePass(commandBuffer, storageTextureBindings, numStorageTextureBindings, storageBufferBindings, numStorageBufferBindings)
+}
+
+// [BindAudioStream] binds a single audio stream to an audio device.
+//
+// [BindAudioStream]: https://wiki.libsdl.org/SDL3/SDL_BindAudioStream
+func BindAudioStream(devid AudioDeviceID, stream *AudioStream) bool {
+	return sdlBindAudioStream(devid, stream)
+}
+
+// [BindAudioStreams] binds a list of audio streams to an audio device.
+//
+// [BindAudioStreams]: https://wiki.libsdl.org/SDL3/SDL_BindAudioStreams
+func BindAudioStreams(devid AudioDeviceID, streams **AudioStream, numStreams int32) bool {
+	return sdlBindAudioStreams(devid, streams, numStreams)
+}
+
+// [BindGPUComputePipeline] binds a compute pipeline on a command buffer for use in compute dispatch.
+//
+// [BindGPUComputePipeline]: https://wiki.libsdl.org/SDL3/SDL_BindGPUComputePipeline
+func BindGPUComputePipeline(computePass *GPUComputePass, computePipeline *GPUComputePipeline) {
+	sdlBindGPUComputePipeline(computePass, computePipeline)
+}
+
+// [BindGPUComputeSamplers] binds texture-sampler pairs for use on the compute shader.
+//
+// [BindGPUComputeSamplers]: https://wiki.libsdl.org/SDL3/SDL_BindGPUComputeSamplers
+func BindGPUComputeSamplers(computePass *GPUComputePass, firstSlot uint32, textureSamplerBindings *GPUTextureSamplerBinding, numBindings uint32) {
+	sdlBindGPUComputeSamplers(computePass, firstSlot, textureSamplerBindings, numBindings)
+}
+
+// [BindGPUComputeStorageBuffers] binds storage buffers as readonly for use on the compute pipeline.
+//
+// [BindGPUComputeStorageBuffers]: https://wiki.libsdl.org/SDL3/SDL_BindGPUComputeStorageBuffers
+func BindGPUComputeStorageBuffers(computePass *GPUComputePass, firstSlot uint32, storageBuffers **GPUBuffer, numBindings uint32) {
+	sdlBindGPUComputeStorageBuffers(computePass, firstSlot, storageBuffers, numBindings)
+}
+
+// [BindGPUComputeStorageTextures] binds storage textures as readonly for use on the compute pipeline.
+//
+// [BindGPUComputeStorageTextures]: https://wiki.libsdl.org/SDL3/SDL_BindGPUComputeStorageTextures
+func BindGPUComputeStorageTextures(computePass *GPUComputePass, firstSlot uint32, storageTextures **GPUTexture, numBindings uint32) {
+	sdlBindGPUComputeStorageTextures(computePass, firstSlot, storageTextures, numBindings)
+}
+
+// [BindGPUFragmentStorageTextures] binds storage textures for use on the fragment shader.
+//
+// [BindGPUFragmentStorageTextures]: https://wiki.libsdl.org/SDL3/SDL_BindGPUFragmentStorageTextures
+func BindGPUFragmentStorageTextures(renderPass *GPURenderPass, firstSlot uint32, storageTextures **GPUTexture, numBindings uint32) {
+	sdlBindGPUFragmentStorageTextures(renderPass, firstSlot, storageTextures, numBindings)
+}
+
+// [BindGPUVertexSamplers] binds texture-sampler pairs for use on the vertex shader.
+//
+// [BindGPUVertexSamplers]: https://wiki.libsdl.org/SDL3/SDL_BindGPUVertexSamplers
+func BindGPUVertexSamplers(renderPass *GPURenderPass, firstSlot uint32, textureSamplerBindings *GPUTextureSamplerBinding, numBindings uint32) {
+	sdlBindGPUVertexSamplers(renderPass, firstSlot, textureSamplerBindings, numBindings)
+}
+
+// [BindGPUVertexStorageTextures] binds storage textures for use on the vertex shader.
+//
+// [BindGPUVertexStorageTextures]: https://wiki.libsdl.org/SDL3/SDL_BindGPUVertexStorageTextures
+func BindGPUVertexStorageTextures(renderPass *GPURenderPass, firstSlot uint32, storageTextures **GPUTexture, numBindings uint32) {
+	sdlBindGPUVertexStorageTextures(renderPass, firstSlot, storageTextures, numBindings)
+}
+
+// [BlitGPUTexture] blits from a source texture region to a destination texture region.
+//
+// [BlitGPUTexture]: https://wiki.libsdl.org/SDL3/SDL_BlitGPUTexture
+func BlitGPUTexture(commandBuffer *GPUCommandBuffer, info *GPUBlitInfo) {
+	sdlBlitGPUTexture(commandBuffer, info)
+}
+
+// [BlitSurface9Grid] performs a scaled blit using the 9-grid algorithm to a destination surface, which may be of a different format.
+//
+// [BlitSurface9Grid]: https://wiki.libsdl.org/SDL3/SDL_BlitSurface9Grid
+func BlitSurface9Grid(src *Surface, srcrect *Rect, leftWidth int32, rightWidth int32, topHeight int32, bottomHeight int32, scale float32, scaleMode ScaleMode, dst *Surface, dstrect *Rect) bool {
+	return sdlBlitSurface9Grid(src, srcrect, leftWidth, rightWidth, topHeight, bottomHeight, scale, scaleMode, dst, dstrect)
+}
+
+// [BlitSurfaceScaled] performs a scaled blit to a destination surface, which may be of a different format.
+//
+// [BlitSurfaceScaled]: https://wiki.libsdl.org/SDL3/SDL_BlitSurfaceScaled
+func BlitSurfaceScaled(src *Surface, srcrect *Rect, dst *Surface, dstrect *Rect, scaleMode ScaleMode) bool {
+	return sdlBlitSurfaceScaled(src, srcrect, dst, dstrect, scaleMode)
+}
+
+// [BlitSurfaceTiled] performs a tiled blit to a destination surface, which may be of a different format.
+//
+// [BlitSurfaceTiled]: https://wiki.libsdl.org/SDL3/SDL_BlitSurfaceTiled
+func BlitSurfaceTiled(src *Surface, srcrect *Rect, dst *Surface, dstrect *Rect) bool {
+	return sdlBlitSurfaceTiled(src, srcrect, dst, dstrect)
+}
+
+// [BlitSurfaceTiledWithScale] performs a scaled and tiled blit to a destination surface, which may be of a different format.
+//
+// [BlitSurfaceTiledWithScale]: https://wiki.libsdl.org/SDL3/SDL_BlitSurfaceTiledWithScale
+func BlitSurfaceTiledWithScale(src *Surface, srcrect *Rect, scale float32, scaleMode ScaleMode, dst *Surface, dstrect *Rect) bool {
+	return sdlBlitSurfaceTiledWithScale(src, srcrect, scale, scaleMode, dst, dstrect)
+}
+
+// [BlitSurfaceUnchecked] performs low-level surface blitting only.
+//
+// [BlitSurfaceUnchecked]: https://wiki.libsdl.org/SDL3/SDL_BlitSurfaceUnchecked
+func BlitSurfaceUnchecked(src *Surface, srcrect *Rect, dst *Surface, dstrect *Rect) bool {
+	return sdlBlitSurfaceUnchecked(src, srcrect, dst, dstrect)
+}
+
+// [BlitSurfaceUncheckedScaled] performs low-level surface scaled blitting only.
+//
+// [BlitSurfaceUncheckedScaled]: https://wiki.libsdl.org/SDL3/SDL_BlitSurfaceUncheckedScaled
+func BlitSurfaceUncheckedScaled(src *Surface, srcrect *Rect, dst *Surface, dstrect *Rect, scaleMode ScaleMode) bool {
+	return sdlBlitSurfaceUncheckedScaled(src, srcrect, dst, dstrect, scaleMode)
+}
+
+// [CalculateGPUTextureFormatSize] calculates the size in bytes of a texture format with dimensions.
+//
+// [CalculateGPUTextureFormatSize]: https://wiki.libsdl.org/SDL3/SDL_CalculateGPUTextureFormatSize
+func CalculateGPUTextureFormatSize(format GPUTextureFormat, width uint32, height uint32, depthOrLayerCount uint32) uint32 {
+	return sdlCalculateGPUTextureFormatSize(format, width, height, depthOrLayerCount)
+}
+
+// [CancelGPUCommandBuffer] cancels a command buffer.
+//
+// [CancelGPUCommandBuffer]: https://wiki.libsdl.org/SDL3/SDL_CancelGPUCommandBuffer
+func CancelGPUCommandBuffer(commandBuffer *GPUCommandBuffer) bool {
+	return sdlCancelGPUCommandBuffer(commandBuffer)
+}
+
+// [ClearClipboardData] clears the clipboard data.
+//
+// [ClearClipboardData]: https://wiki.libsdl.org/SDL3/SDL_ClearClipboardData
+func ClearClipboardData() bool {
+	return sdlClearClipboardData()
+}
+
+// [ClearSurface] clears a surface with a specific color, with floating point precision.
+//
+// [ClearSurface]: https://wiki.libsdl.org/SDL3/SDL_ClearSurface
+func ClearSurface(surface *Surface, r float32, g float32, b float32, a float32) bool {
+	return sdlClearSurface(surface, r, g, b, a)
+}
+
+// [ClickTrayEntry] simulates a click on a tray entry.
+//
+// [ClickTrayEntry]: https://wiki.libsdl.org/SDL3/SDL_ClickTrayEntry
+func ClickTrayEntry(entry *TrayEntry) {
+	sdlClickTrayEntry(entry)
+}
+
+// [CloseAudioDevice] closes a previously-opened audio device.
+//
+// [CloseAudioDevice]: https://wiki.libsdl.org/SDL3/SDL_CloseAudioDevice
+func CloseAudioDevice(devid AudioDeviceID) {
+	sdlCloseAudioDevice(devid)
+}
+
+// [CloseHaptic] closes a haptic device previously opened with [OpenHaptic].
+//
+// [CloseHaptic]: https://wiki.libsdl.org/SDL3/SDL_CloseHaptic
+func CloseHaptic(haptic *Haptic) {
+	sdlCloseHaptic(haptic)
+}
+
+// [CloseSensor] closes a sensor previously opened with [OpenSensor].
+//
+// [CloseSensor]: https://wiki.libsdl.org/SDL3/SDL_CloseSensor
+func CloseSensor(sensor *Sensor) {
+	sdlCloseSensor(sensor)
+}
+
+// [ComposeCustomBlendMode] composes a custom blend mode for renderers.
+//
+// [ComposeCustomBlendMode]: https://wiki.libsdl.org/SDL3/SDL_ComposeCustomBlendMode
+func ComposeCustomBlendMode(srcColorFactor BlendFactor, dstColorFactor BlendFactor, colorOperation BlendOperation, srcAlphaFactor BlendFactor, dstAlphaFactor BlendFactor, alphaOperation BlendOperation) BlendMode {
+	return sdlComposeCustomBlendMode(srcColorFactor, dstColorFactor, colorOperation, srcAlphaFactor, dstAlphaFactor, alphaOperation)
+}
+
+// [ConvertAudioSamples] converts some audio data of one format to another format.
+//
+// [ConvertAudioSamples]: https://wiki.libsdl.org/SDL3/SDL_ConvertAudioSamples
+func ConvertAudioSamples(srcSpec *AudioSpec, srcData *uint8, srcLen int32, dstSpec *AudioSpec, dstData **uint8, dstLen *int32) bool {
+	return sdlConvertAudioSamples(srcSpec, srcData, srcLen, dstSpec, dstData, dstLen)
+}
+
+// [CopyGPUBufferToBuffer] performs a buffer-to-buffer copy.
+//
+// [CopyGPUBufferToBuffer]: https://wiki.libsdl.org/SDL3/SDL_CopyGPUBufferToBuffer
+func CopyGPUBufferToBuffer(copyPass *GPUCopyPass, source *GPUBufferLocation, destination *GPUBufferLocation, size uint32, cycle bool) {
+	sdlCopyGPUBufferToBuffer(copyPass, source, destination, size, cycle)
+}
+
+// [CopyGPUTextureToTexture] performs a texture-to-texture copy.
+//
+// [CopyGPUTextureToTexture]: https://wiki.libsdl.org/SDL3/SDL_CopyGPUTextureToTexture
+func CopyGPUTextureToTexture(copyPass *GPUCopyPass, source *GPUTextureLocation, destination *GPUTextureLocation, w uint32, h uint32, d uint32, cycle bool) {
+	sdlCopyGPUTextureToTexture(copyPass, source, destination, w, h, d, cycle)
+}
+
+// [CreateAudioStream] creates a new audio stream.
+//
+// [CreateAudioStream]: https://wiki.libsdl.org/SDL3/SDL_CreateAudioStream
+func CreateAudioStream(srcSpec *AudioSpec, dstSpec *AudioSpec) *AudioStream {
+	return sdlCreateAudioStream(srcSpec, dstSpec)
+}
+
+// [CreateGPUComputePipeline] creates a pipeline object to be used in a compute workflow.
+//
+// [CreateGPUComputePipeline]: https://wiki.libsdl.org/SDL3/SDL_CreateGPUComputePipeline
+func CreateGPUComputePipeline(device *GPUDevice, createinfo *GPUComputePipelineCreateInfo) *GPUComputePipeline {
+	return sdlCreateGPUComputePipeline(device, createinfo)
+}
+
+// [CreateGPUDeviceWithProperties] creates a GPU context.
+//
+// [CreateGPUDeviceWithProperties]: https://wiki.libsdl.org/SDL3/SDL_CreateGPUDeviceWithProperties
+func CreateGPUDeviceWithProperties(props PropertiesID) *GPUDevice {
+	return sdlCreateGPUDeviceWithProperties(props)
+}
+
+// [CreateGPURenderState] creates custom GPU render state.
+//
+// Available since SDL 3.4.0.
+//
+// [CreateGPURenderState]: https://wiki.libsdl.org/SDL3/SDL_CreateGPURenderState
+func CreateGPURenderState(renderer *Renderer, createinfo *GPURenderStateCreateInfo) *GPURenderState {
+	if sdlCreateGPURenderState == nil {
+		notAvailable("SDL_CreateGPURenderState")
+		return nil
+	}
+	return sdlCreateGPURenderState(renderer, createinfo)
+}
+
+// [CreateHapticEffect] creates a new haptic effect on a specified device.
+//
+// [CreateHapticEffect]: https://wiki.libsdl.org/SDL3/SDL_CreateHapticEffect
+func CreateHapticEffect(haptic *Haptic, effect *HapticEffect) int32 {
+	return sdlCreateHapticEffect(haptic, effect)
+}
+
+// [CreatePopupWindow] creates a child popup window of the specified parent window.
+//
+// [CreatePopupWindow]: https://wiki.libsdl.org/SDL3/SDL_CreatePopupWindow
+func CreatePopupWindow(parent *Window, offsetX int32, offsetY int32, w int32, h int32, flags WindowFlags) *Window {
+	return sdlCreatePopupWindow(parent, offsetX, offsetY, w, h, flags)
+}
+
+// [CreateTray] creates an icon to be placed in the operating system's tray, or equivalent.
+//
+// [CreateTray]: https://wiki.libsdl.org/SDL3/SDL_CreateTray
+func CreateTray(icon *Surface, tooltip string) *Tray {
+	return sdlCreateTray(icon, tooltip)
+}
+
+// [CreateTrayMenu] creates a menu for a system tray.
+//
+// [CreateTrayMenu]: https://wiki.libsdl.org/SDL3/SDL_CreateTrayMenu
+func CreateTrayMenu(tray *Tray) *TrayMenu {
+	return sdlCreateTrayMenu(tray)
+}
+
+// [CreateTraySubmenu] creates a submenu for a system tray entry.
+//
+// [CreateTraySubmenu]: https://wiki.libsdl.org/SDL3/SDL_CreateTraySubmenu
+func CreateTraySubmenu(entry *TrayEntry) *TrayMenu {
+	return sdlCreateTraySubmenu(entry)
+}
+
+// [DestroyGPURenderState] destroys custom GPU render state.
+//
+// Available since SDL 3.4.0.
+//
+// [DestroyGPURenderState]: https://wiki.libsdl.org/SDL3/SDL_DestroyGPURenderState
+func DestroyGPURenderState(state *GPURenderState) {
+	if sdlDestroyGPURenderState == nil {
+		notAvailable("SDL_DestroyGPURenderState")
+		return
+	}
+	sdlDestroyGPURenderState(state)
+}
+
+// [DestroyHapticEffect] destroys a haptic effect on the device.
+//
+// [DestroyHapticEffect]: https://wiki.libsdl.org/SDL3/SDL_DestroyHapticEffect
+func DestroyHapticEffect(haptic *Haptic, effect int32) {
+	sdlDestroyHapticEffect(haptic, effect)
+}
+
+// [DestroyTray] destroys a tray object.
+//
+// [DestroyTray]: https://wiki.libsdl.org/SDL3/SDL_DestroyTray
+func DestroyTray(tray *Tray) {
+	sdlDestroyTray(tray)
+}
+
+// [DetachVirtualJoystick] detaches a virtual joystick.
+//
+// [DetachVirtualJoystick]: https://wiki.libsdl.org/SDL3/SDL_DetachVirtualJoystick
+func DetachVirtualJoystick(instanceId JoystickID) bool {
+	return sdlDetachVirtualJoystick(instanceId)
+}
+
+// [DispatchGPUCompute] dispatches compute work.
+//
+// [DispatchGPUCompute]: https://wiki.libsdl.org/SDL3/SDL_DispatchGPUCompute
+func DispatchGPUCompute(computePass *GPUComputePass, groupcountX uint32, groupcountY uint32, groupcountZ uint32) {
+	sdlDispatchGPUCompute(computePass, groupcountX, groupcountY, groupcountZ)
+}
+
+// [DispatchGPUComputeIndirect] dispatches compute work with parameters set from a buffer.
+//
+// [DispatchGPUComputeIndirect]: https://wiki.libsdl.org/SDL3/SDL_DispatchGPUComputeIndirect
+func DispatchGPUComputeIndirect(computePass *GPUComputePass, buffer *GPUBuffer, offset uint32) {
+	sdlDispatchGPUComputeIndirect(computePass, buffer, offset)
+}
+
+// [DownloadFromGPUBuffer] copies data from a buffer to a transfer buffer on the GPU timeline.
+//
+// [DownloadFromGPUBuffer]: https://wiki.libsdl.org/SDL3/SDL_DownloadFromGPUBuffer
+func DownloadFromGPUBuffer(copyPass *GPUCopyPass, source *GPUBufferRegion, destination *GPUTransferBufferLocation) {
+	sdlDownloadFromGPUBuffer(copyPass, source, destination)
+}
+
+// [DownloadFromGPUTexture] copies data from a texture to a transfer buffer on the GPU timeline.
+//
+// [DownloadFromGPUTexture]: https://wiki.libsdl.org/SDL3/SDL_DownloadFromGPUTexture
+func DownloadFromGPUTexture(copyPass *GPUCopyPass, source *GPUTextureRegion, destination *GPUTextureTransferInfo) {
+	sdlDownloadFromGPUTexture(copyPass, source, destination)
+}
+
+// [DrawGPUIndexedPrimitivesIndirect] draws data using bound graphics state with an index buffer enabled and with draw parameters set from a buffer.
+//
+// [DrawGPUIndexedPrimitivesIndirect]: https://wiki.libsdl.org/SDL3/SDL_DrawGPUIndexedPrimitivesIndirect
+func DrawGPUIndexedPrimitivesIndirect(renderPass *GPURenderPass, buffer *GPUBuffer, offset uint32, drawCount uint32) {
+	sdlDrawGPUIndexedPrimitivesIndirect(renderPass, buffer, offset, drawCount)
+}
+
+// [DrawGPUPrimitivesIndirect] draws data using bound graphics state and with draw parameters set from a buffer.
+//
+// [DrawGPUPrimitivesIndirect]: https://wiki.libsdl.org/SDL3/SDL_DrawGPUPrimitivesIndirect
+func DrawGPUPrimitivesIndirect(renderPass *GPURenderPass, buffer *GPUBuffer, offset uint32, drawCount uint32) {
+	sdlDrawGPUPrimitivesIndirect(renderPass, buffer, offset, drawCount)
+}
+
+// [EGLGetCurrentConfig] gets the currently active EGL config.
+//
+// [EGLGetCurrentConfig]: https://wiki.libsdl.org/SDL3/SDL_EGL_GetCurrentConfig
+func EGLGetCurrentConfig() EGLConfig {
+	return sdlEGL_GetCurrentConfig()
+}
+
+// [EGLGetCurrentDisplay] gets the currently active EGL display.
+//
+// [EGLGetCurrentDisplay]: https://wiki.libsdl.org/SDL3/SDL_EGL_GetCurrentDisplay
+func EGLGetCurrentDisplay() EGLDisplay {
+	return sdlEGL_GetCurrentDisplay()
+}
+
+// [EGLGetProcAddress] gets an EGL library function by name.
+//
+// [EGLGetProcAddress]: https://wiki.libsdl.org/SDL3/SDL_EGL_GetProcAddress
+func EGLGetProcAddress(proc string) FunctionPointer {
+	return sdlEGL_GetProcAddress(proc)
+}
+
+// [EGLGetWindowSurface] gets the EGL surface associated with the window.
+//
+// [EGLGetWindowSurface]: https://wiki.libsdl.org/SDL3/SDL_EGL_GetWindowSurface
+func EGLGetWindowSurface(window *Window) EGLSurface {
+	return sdlEGL_GetWindowSurface(window)
+}
+
+// [EGLSetAttributeCallbacks] sets the callbacks for getting custom EGL config attributes.
+//
+// [EGLSetAttributeCallbacks]: https://wiki.libsdl.org/SDL3/SDL_EGL_SetAttributeCallbacks
+func EGLSetAttributeCallbacks(platformAttribCallback EGLAttribArrayCallback, surfaceAttribCallback EGLIntArrayCallback, contextAttribCallback EGLIntArrayCallback, userdata unsafe.Pointer) {
+	sdlEGL_SetAttributeCallbacks(platformAttribCallback, surfaceAttribCallback, contextAttribCallback, userdata)
+}
+
+// [EndGPUComputePass] ends the current compute pass.
+//
+// [EndGPUComputePass]: https://wiki.libsdl.org/SDL3/SDL_EndGPUComputePass
+func EndGPUComputePass(computePass *GPUComputePass) {
+	sdlEndGPUComputePass(computePass)
+}
+
+// [FillSurfaceRects] performs a fast fill of a set of rectangles with a specific color.
+//
+// [FillSurfaceRects]: https://wiki.libsdl.org/SDL3/SDL_FillSurfaceRects
+func FillSurfaceRects(dst *Surface, rects *Rect, count int32, color uint32) bool {
+	return sdlFillSurfaceRects(dst, rects, count, color)
+}
+
+// [GLExtensionSupported] checks if an OpenGL extension is supported for the current context.
+//
+// [GLExtensionSupported]: https://wiki.libsdl.org/SDL3/SDL_GL_ExtensionSupported
+func GLExtensionSupported(extension string) bool {
+	return sdlGL_ExtensionSupported(extension)
+}
+
+// [GLGetProcAddress] gets an OpenGL function by name.
+//
+// [GLGetProcAddress]: https://wiki.libsdl.org/SDL3/SDL_GL_GetProcAddress
+func GLGetProcAddress(proc string) FunctionPointer {
+	return sdlGL_GetProcAddress(proc)
+}
+
+// [GLGetSwapInterval] gets the swap interval for the current OpenGL context.
+//
+// [GLGetSwapInterval]: https://wiki.libsdl.org/SDL3/SDL_GL_GetSwapInterval
+func GLGetSwapInterval(interval *int32) bool {
+	return sdlGL_GetSwapInterval(interval)
+}
+
+// [GLLoadLibrary] dynamically loads an OpenGL library.
+//
+// [GLLoadLibrary]: https://wiki.libsdl.org/SDL3/SDL_GL_LoadLibrary
+func GLLoadLibrary(path string) bool {
+	return sdlGL_LoadLibrary(path)
+}
+
+// [GLMakeCurrent] sets up an OpenGL context for rendering into an OpenGL window.
+//
+// [GLMakeCurrent]: https://wiki.libsdl.org/SDL3/SDL_GL_MakeCurrent
+func GLMakeCurrent(window *Window, context GLContext) bool {
+	return sdlGL_MakeCurrent(window, context)
+}
+
+// [GLResetAttributes] resets all previously set OpenGL context attributes to their default values.
+//
+// [GLResetAttributes]: https://wiki.libsdl.org/SDL3/SDL_GL_ResetAttributes
+func GLResetAttributes() {
+	sdlGL_ResetAttributes()
+}
+
+// [GLUnloadLibrary] unloads the OpenGL library previously loaded by [GLLoadLibrary].
+//
+// [GLUnloadLibrary]: https://wiki.libsdl.org/SDL3/SDL_GL_UnloadLibrary
+func GLUnloadLibrary() {
+	sdlGL_UnloadLibrary()
+}
+
+// [GPUSupportsProperties] checks for GPU runtime support.
+//
+// [GPUSupportsProperties]: https://wiki.libsdl.org/SDL3/SDL_GPUSupportsProperties
+func GPUSupportsProperties(props PropertiesID) bool {
+	return sdlGPUSupportsProperties(props)
+}
+
+// [GPUSupportsShaderFormats] checks for GPU runtime support.
+//
+// [GPUSupportsShaderFormats]: https://wiki.libsdl.org/SDL3/SDL_GPUSupportsShaderFormats
+func GPUSupportsShaderFormats(formatFlags GPUShaderFormat, name string) bool {
+	return sdlGPUSupportsShaderFormats(formatFlags, name)
+}
+
+// [GPUTextureFormatTexelBlockSize] obtains the texel block size for a texture format.
+//
+// [GPUTextureFormatTexelBlockSize]: https://wiki.libsdl.org/SDL3/SDL_GPUTextureFormatTexelBlockSize
+func GPUTextureFormatTexelBlockSize(format GPUTextureFormat) uint32 {
+	return sdlGPUTextureFormatTexelBlockSize(format)
+}
+
+// [GPUTextureSupportsFormat] determines whether a texture format is supported for a given type and usage.
+//
+// [GPUTextureSupportsFormat]: https://wiki.libsdl.org/SDL3/SDL_GPUTextureSupportsFormat
+func GPUTextureSupportsFormat(device *GPUDevice, format GPUTextureFormat, textureType GPUTextureType, usage GPUTextureUsageFlags) bool {
+	return sdlGPUTextureSupportsFormat(device, format, textureType, usage)
+}
+
+// [GPUTextureSupportsSampleCount] determines if a sample count for a texture format is supported.
+//
+// [GPUTextureSupportsSampleCount]: https://wiki.libsdl.org/SDL3/SDL_GPUTextureSupportsSampleCount
+func GPUTextureSupportsSampleCount(device *GPUDevice, format GPUTextureFormat, sampleCount GPUSampleCount) bool {
+	return sdlGPUTextureSupportsSampleCount(device, format, sampleCount)
+}
+
+// [GamepadConnected] checks if a gamepad has been opened and is currently connected.
+//
+// [GamepadConnected]: https://wiki.libsdl.org/SDL3/SDL_GamepadConnected
+func GamepadConnected(gamepad *Gamepad) bool {
+	return sdlGamepadConnected(gamepad)
+}
+
+// [GamepadEventsEnabled] queries the state of gamepad event processing.
+//
+// [GamepadEventsEnabled]: https://wiki.libsdl.org/SDL3/SDL_GamepadEventsEnabled
+func GamepadEventsEnabled() bool {
+	return sdlGamepadEventsEnabled()
+}
+
+// [GamepadHasAxis] queries whether a gamepad has a given axis.
+//
+// [GamepadHasAxis]: https://wiki.libsdl.org/SDL3/SDL_GamepadHasAxis
+func GamepadHasAxis(gamepad *Gamepad, axis GamepadAxis) bool {
+	return sdlGamepadHasAxis(gamepad, axis)
+}
+
+// [GamepadHasButton] queries whether a gamepad has a given button.
+//
+// [GamepadHasButton]: https://wiki.libsdl.org/SDL3/SDL_GamepadHasButton
+func GamepadHasButton(gamepad *Gamepad, button GamepadButton) bool {
+	return sdlGamepadHasButton(gamepad, button)
+}
+
+// [GamepadHasSensor] returns whether a gamepad has a particular sensor.
+//
+// [GamepadHasSensor]: https://wiki.libsdl.org/SDL3/SDL_GamepadHasSensor
+func GamepadHasSensor(gamepad *Gamepad, sensorType SensorType) bool {
+	return sdlGamepadHasSensor(gamepad, sensorType)
+}
+
+// [GamepadSensorEnabled] queries whether sensor data reporting is enabled for a gamepad.
+//
+// [GamepadSensorEnabled]: https://wiki.libsdl.org/SDL3/SDL_GamepadSensorEnabled
+func GamepadSensorEnabled(gamepad *Gamepad, sensorType SensorType) bool {
+	return sdlGamepadSensorEnabled(gamepad, sensorType)
+}
+
+// [GenerateMipmapsForGPUTexture] generates mipmaps for the given texture.
+//
+// [GenerateMipmapsForGPUTexture]: https://wiki.libsdl.org/SDL3/SDL_GenerateMipmapsForGPUTexture
+func GenerateMipmapsForGPUTexture(commandBuffer *GPUCommandBuffer, texture *GPUTexture) {
+	sdlGenerateMipmapsForGPUTexture(commandBuffer, texture)
+}
+
+// [GetAudioDeviceChannelMap] gets the current channel map of an audio device.
+//
+// [GetAudioDeviceChannelMap]: https://wiki.libsdl.org/SDL3/SDL_GetAudioDeviceChannelMap
+func GetAudioDeviceChannelMap(devid AudioDeviceID, count *int32) *int32 {
+	return sdlGetAudioDeviceChannelMap(devid, count)
+}
+
+// [GetAudioDeviceFormat] gets the current audio format of a specific audio device.
+//
+// [GetAudioDeviceFormat]: https://wiki.libsdl.org/SDL3/SDL_GetAudioDeviceFormat
+func GetAudioDeviceFormat(devid AudioDeviceID, spec *AudioSpec, sampleFrames *int32) bool {
+	return sdlGetAudioDeviceFormat(devid, spec, sampleFrames)
+}
+
+// [GetAudioDeviceGain] gets the gain of an audio device.
+//
+// [GetAudioDeviceGain]: https://wiki.libsdl.org/SDL3/SDL_GetAudioDeviceGain
+func GetAudioDeviceGain(devid AudioDeviceID) float32 {
+	return sdlGetAudioDeviceGain(devid)
+}
+
+// [GetAudioDeviceName] gets the human-readable name of a specific audio device.
+//
+// [GetAudioDeviceName]: https://wiki.libsdl.org/SDL3/SDL_GetAudioDeviceName
+func GetAudioDeviceName(devid AudioDeviceID) string {
+	return sdlGetAudioDeviceName(devid)
+}
+
+// [GetAudioFormatName] gets the human readable name of an audio format.
+//
+// [GetAudioFormatName]: https://wiki.libsdl.org/SDL3/SDL_GetAudioFormatName
+func GetAudioFormatName(format AudioFormat) string {
+	return sdlGetAudioFormatName(format)
+}
+
+// [GetAudioPlaybackDevices] gets a list of currently-connected audio playback devices.
+//
+// [GetAudioPlaybackDevices]: https://wiki.libsdl.org/SDL3/SDL_GetAudioPlaybackDevices
+func GetAudioPlaybackDevices(count *int32) *AudioDeviceID {
+	return sdlGetAudioPlaybackDevices(count)
+}
+
+// [GetAudioRecordingDevices] gets a list of currently-connected audio recording devices.
+//
+// [GetAudioRecordingDevices]: https://wiki.libsdl.org/SDL3/SDL_GetAudioRecordingDevices
+func GetAudioRecordingDevices(count *int32) *AudioDeviceID {
+	return sdlGetAudioRecordingDevices(count)
+}
+
+// [GetAudioStreamAvailable] gets the number of converted/resampled bytes available.
+//
+// [GetAudioStreamAvailable]: https://wiki.libsdl.org/SDL3/SDL_GetAudioStreamAvailable
+func GetAudioStreamAvailable(stream *AudioStream) int32 {
+	return sdlGetAudioStreamAvailable(stream)
+}
+
+// [GetAudioStreamData] gets converted/resampled data from the stream.
+//
+// [GetAudioStreamData]: https://wiki.libsdl.org/SDL3/SDL_GetAudioStreamData
+func GetAudioStreamData(stream *AudioStream, buf unsafe.Pointer, len int32) int32 {
+	return sdlGetAudioStreamData(stream, buf, len)
+}
+
+// [GetAudioStreamInputChannelMap] gets the current input channel map of an audio stream.
+//
+// [GetAudioStreamInputChannelMap]: https://wiki.libsdl.org/SDL3/SDL_GetAudioStreamInputChannelMap
+func GetAudioStreamInputChannelMap(stream *AudioStream, count *int32) *int32 {
+	return sdlGetAudioStreamInputChannelMap(stream, count)
+}
+
+// [GetAudioStreamOutputChannelMap] gets the current output channel map of an audio stream.
+//
+// [GetAudioStreamOutputChannelMap]: https://wiki.libsdl.org/SDL3/SDL_GetAudioStreamOutputChannelMap
+func GetAudioStreamOutputChannelMap(stream *AudioStream, count *int32) *int32 {
+	return sdlGetAudioStreamOutputChannelMap(stream, count)
+}
+
+// [GetAudioStreamProperties] gets the properties associated with an audio stream.
+//
+// [GetAudioStreamProperties]: https://wiki.libsdl.org/SDL3/SDL_GetAudioStreamProperties
+func GetAudioStreamProperties(stream *AudioStream) PropertiesID {
+	return sdlGetAudioStreamProperties(stream)
+}
+
+// [GetClipboardData] gets the data from the clipboard for a given mime type.
+//
+// [GetClipboardData]: https://wiki.libsdl.org/SDL3/SDL_GetClipboardData
+func GetClipboardData(mimeType string, size *uint64) unsafe.Pointer {
+	return sdlGetClipboardData(mimeType, size)
+}
+
+// [GetClipboardMimeTypes] retrieves the list of mime types available in the clipboard.
+//
+// [GetClipboardMimeTypes]: https://wiki.libsdl.org/SDL3/SDL_GetClipboardMimeTypes
+func GetClipboardMimeTypes(numMimeTypes *uint64) **byte {
+	return sdlGetClipboardMimeTypes(numMimeTypes)
+}
+
+// [GetEventDescription] generates an English description of an event.
+//
+// Available since SDL 3.4.0.
+//
+// [GetEventDescription]: https://wiki.libsdl.org/SDL3/SDL_GetEventDescription
+func GetEventDescription(event *Event, buf *byte, buflen int32) int32 {
+	if sdlGetEventDescription == nil {
+		notAvailable("SDL_GetEventDescription")
+		return 0
+	}
+	return sdlGetEventDescription(event, buf, buflen)
+}
+
+// [GetGamepadAppleSFSymbolsNameForAxis] returns the sfSymbolsName for a given axis on a gamepad on Apple platforms.
+//
+// [GetGamepadAppleSFSymbolsNameForAxis]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadAppleSFSymbolsNameForAxis
+func GetGamepadAppleSFSymbolsNameForAxis(gamepad *Gamepad, axis GamepadAxis) string {
+	return sdlGetGamepadAppleSFSymbolsNameForAxis(gamepad, axis)
+}
+
+// [GetGamepadAppleSFSymbolsNameForButton] returns the sfSymbolsName for a given button on a gamepad on Apple platforms.
+//
+// [GetGamepadAppleSFSymbolsNameForButton]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadAppleSFSymbolsNameForButton
+func GetGamepadAppleSFSymbolsNameForButton(gamepad *Gamepad, button GamepadButton) string {
+	return sdlGetGamepadAppleSFSymbolsNameForButton(gamepad, button)
+}
+
+// [GetGamepadAxis] gets the current state of an axis control on a gamepad.
+//
+// [GetGamepadAxis]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadAxis
+func GetGamepadAxis(gamepad *Gamepad, axis GamepadAxis) int16 {
+	return sdlGetGamepadAxis(gamepad, axis)
+}
+
+// [GetGamepadAxisFromString] converts a string into a [GamepadAxis] enum.
+//
+// [GetGamepadAxisFromString]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadAxisFromString
+func GetGamepadAxisFromString(str string) GamepadAxis {
+	return sdlGetGamepadAxisFromString(str)
+}
+
+// [GetGamepadButton] gets the current state of a button on a gamepad.
+//
+// [GetGamepadButton]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadButton
+func GetGamepadButton(gamepad *Gamepad, button GamepadButton) bool {
+	return sdlGetGamepadButton(gamepad, button)
+}
+
+// [GetGamepadButtonFromString] converts a string into a [GamepadButton] enum.
+//
+// [GetGamepadButtonFromString]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadButtonFromString
+func GetGamepadButtonFromString(str string) GamepadButton {
+	return sdlGetGamepadButtonFromString(str)
+}
+
+// [GetGamepadButtonLabel] gets the label of a button on a gamepad.
+//
+// [GetGamepadButtonLabel]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadButtonLabel
+func GetGamepadButtonLabel(gamepad *Gamepad, button GamepadButton) GamepadButtonLabel {
+	return sdlGetGamepadButtonLabel(gamepad, button)
+}
+
+// [GetGamepadButtonLabelForType] gets the label of a button on a gamepad type.
+//
+// [GetGamepadButtonLabelForType]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadButtonLabelForType
+func GetGamepadButtonLabelForType(gamepadType GamepadType, button GamepadButton) GamepadButtonLabel {
+	return sdlGetGamepadButtonLabelForType(gamepadType, button)
+}
+
+// [GetGamepadConnectionState] gets the connection state of a gamepad.
+//
+// [GetGamepadConnectionState]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadConnectionState
+func GetGamepadConnectionState(gamepad *Gamepad) JoystickConnectionState {
+	return sdlGetGamepadConnectionState(gamepad)
+}
+
+// [GetGamepadFirmwareVersion] gets the firmware version of an opened gamepad, if available.
+//
+// [GetGamepadFirmwareVersion]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadFirmwareVersion
+func GetGamepadFirmwareVersion(gamepad *Gamepad) uint16 {
+	return sdlGetGamepadFirmwareVersion(gamepad)
+}
+
+// [GetGamepadFromPlayerIndex] gets the [Gamepad] associated with a player index.
+//
+// [GetGamepadFromPlayerIndex]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadFromPlayerIndex
+func GetGamepadFromPlayerIndex(playerIndex int32) *Gamepad {
+	return sdlGetGamepadFromPlayerIndex(playerIndex)
+}
+
+// [GetGamepadID] gets the instance ID of an opened gamepad.
+//
+// [GetGamepadID]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadID
+func GetGamepadID(gamepad *Gamepad) JoystickID {
+	return sdlGetGamepadID(gamepad)
+}
+
+// [GetGamepadJoystick] gets the underlying joystick from a gamepad.
+//
+// [GetGamepadJoystick]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadJoystick
+func GetGamepadJoystick(gamepad *Gamepad) *Joystick {
+	return sdlGetGamepadJoystick(gamepad)
+}
+
+// [GetGamepadMappings] gets the current gamepad mappings.
+//
+// [GetGamepadMappings]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadMappings
+func GetGamepadMappings(count *int32) **byte {
+	return sdlGetGamepadMappings(count)
+}
+
+// [GetGamepadPath] gets the implementation-dependent path for an opened gamepad.
+//
+// [GetGamepadPath]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadPath
+func GetGamepadPath(gamepad *Gamepad) string {
+	return sdlGetGamepadPath(gamepad)
+}
+
+// [GetGamepadPathForID] gets the implementation dependent path of a gamepad.
+//
+// [GetGamepadPathForID]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadPathForID
+func GetGamepadPathForID(instanceId JoystickID) string {
+	return sdlGetGamepadPathForID(instanceId)
+}
+
+// [GetGamepadPlayerIndex] gets the player index of an opened gamepad.
+//
+// [GetGamepadPlayerIndex]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadPlayerIndex
+func GetGamepadPlayerIndex(gamepad *Gamepad) int32 {
+	return sdlGetGamepadPlayerIndex(gamepad)
+}
+
+// [GetGamepadPlayerIndexForID] gets the player index of a gamepad.
+//
+// [GetGamepadPlayerIndexForID]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadPlayerIndexForID
+func GetGamepadPlayerIndexForID(instanceId JoystickID) int32 {
+	return sdlGetGamepadPlayerIndexForID(instanceId)
+}
+
+// [GetGamepadPowerInfo] gets the battery state of a gamepad.
+//
+// [GetGamepadPowerInfo]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadPowerInfo
+func GetGamepadPowerInfo(gamepad *Gamepad, percent *int32) PowerState {
+	return sdlGetGamepadPowerInfo(gamepad, percent)
+}
+
+// [GetGamepadProduct] gets the USB product ID of an opened gamepad, if available.
+//
+// [GetGamepadProduct]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadProduct
+func GetGamepadProduct(gamepad *Gamepad) uint16 {
+	return sdlGetGamepadProduct(gamepad)
+}
+
+// [GetGamepadProductForID] gets the USB product ID of a gamepad, if available.
+//
+// [GetGamepadProductForID]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadProductForID
+func GetGamepadProductForID(instanceId JoystickID) uint16 {
+	return sdlGetGamepadProductForID(instanceId)
+}
+
+// [GetGamepadProductVersion] gets the product version of an opened gamepad, if available.
+//
+// [GetGamepadProductVersion]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadProductVersion
+func GetGamepadProductVersion(gamepad *Gamepad) uint16 {
+	return sdlGetGamepadProductVersion(gamepad)
+}
+
+// [GetGamepadProductVersionForID] gets the product version of a gamepad, if available.
+//
+// [GetGamepadProductVersionForID]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadProductVersionForID
+func GetGamepadProductVersionForID(instanceId JoystickID) uint16 {
+	return sdlGetGamepadProductVersionForID(instanceId)
+}
+
+// [GetGamepadProperties] gets the properties associated with an opened gamepad.
+//
+// [GetGamepadProperties]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadProperties
+func GetGamepadProperties(gamepad *Gamepad) PropertiesID {
+	return sdlGetGamepadProperties(gamepad)
+}
+
+// [GetGamepadSensorData] gets the current state of a gamepad sensor.
+//
+// [GetGamepadSensorData]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadSensorData
+func GetGamepadSensorData(gamepad *Gamepad, sensorType SensorType, data *float32, numValues int32) bool {
+	return sdlGetGamepadSensorData(gamepad, sensorType, data, numValues)
+}
+
+// [GetGamepadSensorDataRate] gets the data rate (number of events per second) of a gamepad sensor.
+//
+// [GetGamepadSensorDataRate]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadSensorDataRate
+func GetGamepadSensorDataRate(gamepad *Gamepad, sensorType SensorType) float32 {
+	return sdlGetGamepadSensorDataRate(gamepad, sensorType)
+}
+
+// [GetGamepadSteamHandle] gets the Steam Input handle of an opened gamepad, if available.
+//
+// [GetGamepadSteamHandle]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadSteamHandle
+func GetGamepadSteamHandle(gamepad *Gamepad) uint64 {
+	return sdlGetGamepadSteamHandle(gamepad)
+}
+
+// [GetGamepadStringForAxis] converts from a [GamepadAxis] enum to a string.
+//
+// [GetGamepadStringForAxis]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadStringForAxis
+func GetGamepadStringForAxis(axis GamepadAxis) string {
+	return sdlGetGamepadStringForAxis(axis)
+}
+
+// [GetGamepadTouchpadFinger] gets the current state of a finger on a touchpad on a gamepad.
+//
+// [GetGamepadTouchpadFinger]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadTouchpadFinger
+func GetGamepadTouchpadFinger(gamepad *Gamepad, touchpad int32, finger int32, down *bool, x *float32, y *float32, pressure *float32) bool {
+	return sdlGetGamepadTouchpadFinger(gamepad, touchpad, finger, down, x, y, pressure)
+}
+
+// [GetGamepadTypeForID] gets the type of a gamepad.
+//
+// [GetGamepadTypeForID]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadTypeForID
+func GetGamepadTypeForID(instanceId JoystickID) GamepadType {
+	return sdlGetGamepadTypeForID(instanceId)
+}
+
+// [GetGamepadTypeFromString] converts a string into a [GamepadType] enum.
+//
+// [GetGamepadTypeFromString]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadTypeFromString
+func GetGamepadTypeFromString(str string) GamepadType {
+	return sdlGetGamepadTypeFromString(str)
+}
+
+// [GetGamepadVendor] gets the USB vendor ID of an opened gamepad, if available.
+//
+// [GetGamepadVendor]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadVendor
+func GetGamepadVendor(gamepad *Gamepad) uint16 {
+	return sdlGetGamepadVendor(gamepad)
+}
+
+// [GetGamepadVendorForID] gets the USB vendor ID of a gamepad, if available.
+//
+// [GetGamepadVendorForID]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadVendorForID
+func GetGamepadVendorForID(instanceId JoystickID) uint16 {
+	return sdlGetGamepadVendorForID(instanceId)
+}
+
+// [GetHapticEffectStatus] gets the status of the current effect on the specified haptic device.
+//
+// [GetHapticEffectStatus]: https://wiki.libsdl.org/SDL3/SDL_GetHapticEffectStatus
+func GetHapticEffectStatus(haptic *Haptic, effect int32) bool {
+	return sdlGetHapticEffectStatus(haptic, effect)
+}
+
+// [GetHapticFeatures] gets the haptic device's supported features in bitwise manner.
+//
+// [GetHapticFeatures]: https://wiki.libsdl.org/SDL3/SDL_GetHapticFeatures
+func GetHapticFeatures(haptic *Haptic) uint32 {
+	return sdlGetHapticFeatures(haptic)
+}
+
+// [GetHapticFromID] gets the [Haptic] associated with an instance ID, if it has been opened.
+//
+// [GetHapticFromID]: https://wiki.libsdl.org/SDL3/SDL_GetHapticFromID
+func GetHapticFromID(instanceId HapticID) *Haptic {
+	return sdlGetHapticFromID(instanceId)
+}
+
+// [GetHapticID] gets the instance ID of an opened haptic device.
+//
+// [GetHapticID]: https://wiki.libsdl.org/SDL3/SDL_GetHapticID
+func GetHapticID(haptic *Haptic) HapticID {
+	return sdlGetHapticID(haptic)
+}
+
+// [GetHapticName] gets the implementation dependent name of a haptic device.
+//
+// [GetHapticName]: https://wiki.libsdl.org/SDL3/SDL_GetHapticName
+func GetHapticName(haptic *Haptic) string {
+	return sdlGetHapticName(haptic)
+}
+
+// [GetHapticNameForID] gets the implementation dependent name of a haptic device.
+//
+// [GetHapticNameForID]: https://wiki.libsdl.org/SDL3/SDL_GetHapticNameForID
+func GetHapticNameForID(instanceId HapticID) string {
+	return sdlGetHapticNameForID(instanceId)
+}
+
+// [GetHaptics] gets a list of currently connected haptic devices.
+//
+// [GetHaptics]: https://wiki.libsdl.org/SDL3/SDL_GetHaptics
+func GetHaptics(count *int32) *HapticID {
+	return sdlGetHaptics(count)
+}
+
+// [GetMasksForPixelFormat] converts one of the enumerated pixel formats to a bpp value and RGBA masks.
+//
+// [GetMasksForPixelFormat]: https://wiki.libsdl.org/SDL3/SDL_GetMasksForPixelFormat
+func GetMasksForPixelFormat(format PixelFormat, bpp *int32, Rmask *uint32, Gmask *uint32, Bmask *uint32, Amask *uint32) bool {
+	return sdlGetMasksForPixelFormat(format, bpp, Rmask, Gmask, Bmask, Amask)
+}
+
+// [GetMaxHapticEffects] gets the number of effects a haptic device can store.
+//
+// [GetMaxHapticEffects]: https://wiki.libsdl.org/SDL3/SDL_GetMaxHapticEffects
+func GetMaxHapticEffects(haptic *Haptic) int32 {
+	return sdlGetMaxHapticEffects(haptic)
+}
+
+// [GetMaxHapticEffectsPlaying] gets the number of effects a haptic device can play at the same time.
+//
+// [GetMaxHapticEffectsPlaying]: https://wiki.libsdl.org/SDL3/SDL_GetMaxHapticEffectsPlaying
+func GetMaxHapticEffectsPlaying(haptic *Haptic) int32 {
+	return sdlGetMaxHapticEffectsPlaying(haptic)
+}
+
+// [GetNumGamepadTouchpadFingers] gets the number of supported simultaneous fingers on a touchpad on a game gamepad.
+//
+// [GetNumGamepadTouchpadFingers]: https://wiki.libsdl.org/SDL3/SDL_GetNumGamepadTouchpadFingers
+func GetNumGamepadTouchpadFingers(gamepad *Gamepad, touchpad int32) int32 {
+	return sdlGetNumGamepadTouchpadFingers(gamepad, touchpad)
+}
+
+// [GetNumGamepadTouchpads] gets the number of touchpads on a gamepad.
+//
+// [GetNumGamepadTouchpads]: https://wiki.libsdl.org/SDL3/SDL_GetNumGamepadTouchpads
+func GetNumGamepadTouchpads(gamepad *Gamepad) int32 {
+	return sdlGetNumGamepadTouchpads(gamepad)
+}
+
+// [GetNumHapticAxes] gets the number of haptic axes the device has.
+//
+// [GetNumHapticAxes]: https://wiki.libsdl.org/SDL3/SDL_GetNumHapticAxes
+func GetNumHapticAxes(haptic *Haptic) int32 {
+	return sdlGetNumHapticAxes(haptic)
+}
+
+// [GetPenDeviceType] gets the device type of the given pen.
+//
+// Available since SDL 3.4.0.
+//
+// [GetPenDeviceType]: https://wiki.libsdl.org/SDL3/SDL_GetPenDeviceType
+func GetPenDeviceType(instanceId PenID) PenDeviceType {
+	if sdlGetPenDeviceType == nil {
+		notAvailable("SDL_GetPenDeviceType")
+		return 0
+	}
+	return sdlGetPenDeviceType(instanceId)
+}
+
+// [GetPixelFormatForMasks] converts a bpp value and RGBA masks to an enumerated pixel format.
+//
+// [GetPixelFormatForMasks]: https://wiki.libsdl.org/SDL3/SDL_GetPixelFormatForMasks
+func GetPixelFormatForMasks(bpp int32, Rmask uint32, Gmask uint32, Bmask uint32, Amask uint32) PixelFormat {
+	return sdlGetPixelFormatForMasks(bpp, Rmask, Gmask, Bmask, Amask)
+}
+
+// [GetPixelFormatName] gets the human readable name of a pixel format.
+//
+// [GetPixelFormatName]: https://wiki.libsdl.org/SDL3/SDL_GetPixelFormatName
+func GetPixelFormatName(format PixelFormat) string {
+	return sdlGetPixelFormatName(format)
+}
+
+// [GetPlatform] gets the name of the platform.
+//
+// [GetPlatform]: https://wiki.libsdl.org/SDL3/SDL_GetPlatform
+func GetPlatform() string {
+	return sdlGetPlatform()
+}
+
+// [GetRGB] gets RGB values from a pixel in the specified format.
+//
+// [GetRGB]: https://wiki.libsdl.org/SDL3/SDL_GetRGB
+func GetRGB(pixel uint32, format *PixelFormatDetails, palette *Palette, r *uint8, g *uint8, b *uint8) {
+	sdlGetRGB(pixel, format, palette, r, g, b)
+}
+
+// [GetRGBA] gets RGBA values from a pixel in the specified format.
+//
+// [GetRGBA]: https://wiki.libsdl.org/SDL3/SDL_GetRGBA
+func GetRGBA(pixel uint32, format *PixelFormatDetails, palette *Palette, r *uint8, g *uint8, b *uint8, a *uint8) {
+	sdlGetRGBA(pixel, format, palette, r, g, b, a)
+}
+
+// [GetRealGamepadType] gets the type of an opened gamepad, ignoring any mapping override.
+//
+// [GetRealGamepadType]: https://wiki.libsdl.org/SDL3/SDL_GetRealGamepadType
+func GetRealGamepadType(gamepad *Gamepad) GamepadType {
+	return sdlGetRealGamepadType(gamepad)
+}
+
+// [GetRealGamepadTypeForID] gets the type of a gamepad, ignoring any mapping override.
+//
+// [GetRealGamepadTypeForID]: https://wiki.libsdl.org/SDL3/SDL_GetRealGamepadTypeForID
+func GetRealGamepadTypeForID(instanceId JoystickID) GamepadType {
+	return sdlGetRealGamepadTypeForID(instanceId)
+}
+
+// [GetSandbox] gets the application sandbox environment, if any.
+//
+// [GetSandbox]: https://wiki.libsdl.org/SDL3/SDL_GetSandbox
+func GetSandbox() Sandbox {
+	return sdlGetSandbox()
+}
+
+// [GetSensorData] gets the current state of an opened sensor.
+//
+// [GetSensorData]: https://wiki.libsdl.org/SDL3/SDL_GetSensorData
+func GetSensorData(sensor *Sensor, data *float32, numValues int32) bool {
+	return sdlGetSensorData(sensor, data, numValues)
+}
+
+// [GetSensorFromID] returns the [Sensor] associated with an instance ID.
+//
+// [GetSensorFromID]: https://wiki.libsdl.org/SDL3/SDL_GetSensorFromID
+func GetSensorFromID(instanceId SensorID) *Sensor {
+	return sdlGetSensorFromID(instanceId)
+}
+
+// [GetSensorID] gets the instance ID of a sensor.
+//
+// [GetSensorID]: https://wiki.libsdl.org/SDL3/SDL_GetSensorID
+func GetSensorID(sensor *Sensor) SensorID {
+	return sdlGetSensorID(sensor)
+}
+
+// [GetSensorName] gets the implementation dependent name of a sensor.
+//
+// [GetSensorName]: https://wiki.libsdl.org/SDL3/SDL_GetSensorName
+func GetSensorName(sensor *Sensor) string {
+	return sdlGetSensorName(sensor)
+}
+
+// [GetSensorNameForID] gets the implementation dependent name of a sensor.
+//
+// [GetSensorNameForID]: https://wiki.libsdl.org/SDL3/SDL_GetSensorNameForID
+func GetSensorNameForID(instanceId SensorID) string {
+	return sdlGetSensorNameForID(instanceId)
+}
+
+// [GetSensorNonPortableType] gets the platform dependent type of a sensor.
+//
+// [GetSensorNonPortableType]: https://wiki.libsdl.org/SDL3/SDL_GetSensorNonPortableType
+func GetSensorNonPortableType(sensor *Sensor) int32 {
+	return sdlGetSensorNonPortableType(sensor)
+}
+
+// [GetSensorNonPortableTypeForID] gets the platform dependent type of a sensor.
+//
+// [GetSensorNonPortableTypeForID]: https://wiki.libsdl.org/SDL3/SDL_GetSensorNonPortableTypeForID
+func GetSensorNonPortableTypeForID(instanceId SensorID) int32 {
+	return sdlGetSensorNonPortableTypeForID(instanceId)
+}
+
+// [GetSensorProperties] gets the properties associated with a sensor.
+//
+// [GetSensorProperties]: https://wiki.libsdl.org/SDL3/SDL_GetSensorProperties
+func GetSensorProperties(sensor *Sensor) PropertiesID {
+	return sdlGetSensorProperties(sensor)
+}
+
+// [GetSensorType] gets the type of a sensor.
+//
+// [GetSensorType]: https://wiki.libsdl.org/SDL3/SDL_GetSensorType
+func GetSensorType(sensor *Sensor) SensorType {
+	return sdlGetSensorType(sensor)
+}
+
+// [GetSensorTypeForID] gets the type of a sensor.
+//
+// [GetSensorTypeForID]: https://wiki.libsdl.org/SDL3/SDL_GetSensorTypeForID
+func GetSensorTypeForID(instanceId SensorID) SensorType {
+	return sdlGetSensorTypeForID(instanceId)
+}
+
+// [GetSensors] gets a list of currently connected sensors.
+//
+// [GetSensors]: https://wiki.libsdl.org/SDL3/SDL_GetSensors
+func GetSensors(count *int32) *SensorID {
+	return sdlGetSensors(count)
+}
+
+// [GetSilenceValueForFormat] gets the appropriate memset value for silencing an audio format.
+//
+// [GetSilenceValueForFormat]: https://wiki.libsdl.org/SDL3/SDL_GetSilenceValueForFormat
+func GetSilenceValueForFormat(format AudioFormat) int32 {
+	return sdlGetSilenceValueForFormat(format)
+}
+
+// [GetTouchDeviceName] gets the touch device name as reported from the driver.
+//
+// [GetTouchDeviceName]: https://wiki.libsdl.org/SDL3/SDL_GetTouchDeviceName
+func GetTouchDeviceName(touchID TouchID) string {
+	return sdlGetTouchDeviceName(touchID)
+}
+
+// [GetTouchDeviceType] gets the type of the given touch device.
+//
+// [GetTouchDeviceType]: https://wiki.libsdl.org/SDL3/SDL_GetTouchDeviceType
+func GetTouchDeviceType(touchID TouchID) TouchDeviceType {
+	return sdlGetTouchDeviceType(touchID)
+}
+
+// [GetTouchDevices] gets a list of registered touch devices.
+//
+// [GetTouchDevices]: https://wiki.libsdl.org/SDL3/SDL_GetTouchDevices
+func GetTouchDevices(count *int32) *TouchID {
+	return sdlGetTouchDevices(count)
+}
+
+// [GetTouchFingers] gets a list of active fingers for a given touch device.
+//
+// [GetTouchFingers]: https://wiki.libsdl.org/SDL3/SDL_GetTouchFingers
+func GetTouchFingers(touchID TouchID, count *int32) **Finger {
+	return sdlGetTouchFingers(touchID, count)
+}
+
+// [GetTrayEntries] returns a list of entries in the menu, in order.
+//
+// [GetTrayEntries]: https://wiki.libsdl.org/SDL3/SDL_GetTrayEntries
+func GetTrayEntries(menu *TrayMenu, size *int32) **TrayEntry {
+	return sdlGetTrayEntries(menu, size)
+}
+
+// [GetTrayEntryChecked] gets whether or not an entry is checked.
+//
+// [GetTrayEntryChecked]: https://wiki.libsdl.org/SDL3/SDL_GetTrayEntryChecked
+func GetTrayEntryChecked(entry *TrayEntry) bool {
+	return sdlGetTrayEntryChecked(entry)
+}
+
+// [GetTrayEntryEnabled] gets whether or not an entry is enabled.
+//
+// [GetTrayEntryEnabled]: https://wiki.libsdl.org/SDL3/SDL_GetTrayEntryEnabled
+func GetTrayEntryEnabled(entry *TrayEntry) bool {
+	return sdlGetTrayEntryEnabled(entry)
+}
+
+// [GetTrayEntryLabel] gets the label of an entry.
+//
+// [GetTrayEntryLabel]: https://wiki.libsdl.org/SDL3/SDL_GetTrayEntryLabel
+func GetTrayEntryLabel(entry *TrayEntry) string {
+	return sdlGetTrayEntryLabel(entry)
+}
+
+// [GetTrayEntryParent] gets the menu containing a certain tray entry.
+//
+// [GetTrayEntryParent]: https://wiki.libsdl.org/SDL3/SDL_GetTrayEntryParent
+func GetTrayEntryParent(entry *TrayEntry) *TrayMenu {
+	return sdlGetTrayEntryParent(entry)
+}
+
+// [GetTrayMenu] gets a previously created tray menu.
+//
+// [GetTrayMenu]: https://wiki.libsdl.org/SDL3/SDL_GetTrayMenu
+func GetTrayMenu(tray *Tray) *TrayMenu {
+	return sdlGetTrayMenu(tray)
+}
+
+// [GetTrayMenuParentEntry] gets the entry for which the menu is a submenu, if the current menu is a submenu.
+//
+// [GetTrayMenuParentEntry]: https://wiki.libsdl.org/SDL3/SDL_GetTrayMenuParentEntry
+func GetTrayMenuParentEntry(menu *TrayMenu) *TrayEntry {
+	return sdlGetTrayMenuParentEntry(menu)
+}
+
+// [GetTrayMenuParentTray] gets the tray for which this menu is the first-level menu, if the current menu isn't a submenu.
+//
+// [GetTrayMenuParentTray]: https://wiki.libsdl.org/SDL3/SDL_GetTrayMenuParentTray
+func GetTrayMenuParentTray(menu *TrayMenu) *Tray {
+	return sdlGetTrayMenuParentTray(menu)
+}
+
+// [GetTraySubmenu] gets a previously created tray entry submenu.
+//
+// [GetTraySubmenu]: https://wiki.libsdl.org/SDL3/SDL_GetTraySubmenu
+func GetTraySubmenu(entry *TrayEntry) *TrayMenu {
+	return sdlGetTraySubmenu(entry)
+}
+
+// [GetWindowICCProfile] gets the raw ICC profile data for the screen the window is currently on.
+//
+// [GetWindowICCProfile]: https://wiki.libsdl.org/SDL3/SDL_GetWindowICCProfile
+func GetWindowICCProfile(window *Window, size *uint64) unsafe.Pointer {
+	return sdlGetWindowICCProfile(window, size)
+}
+
+// [HapticEffectSupported] checks to see if an effect is supported by a haptic device.
+//
+// [HapticEffectSupported]: https://wiki.libsdl.org/SDL3/SDL_HapticEffectSupported
+func HapticEffectSupported(haptic *Haptic, effect *HapticEffect) bool {
+	return sdlHapticEffectSupported(haptic, effect)
+}
+
+// [HapticRumbleSupported] checks whether rumble is supported on a haptic device.
+//
+// [HapticRumbleSupported]: https://wiki.libsdl.org/SDL3/SDL_HapticRumbleSupported
+func HapticRumbleSupported(haptic *Haptic) bool {
+	return sdlHapticRumbleSupported(haptic)
+}
+
+// [HasClipboardData] queries whether there is data in the clipboard for the provided mime type.
+//
+// [HasClipboardData]: https://wiki.libsdl.org/SDL3/SDL_HasClipboardData
+func HasClipboardData(mimeType string) bool {
+	return sdlHasClipboardData(mimeType)
+}
+
+// [HasClipboardText] queries whether the clipboard exists and contains a non-empty text string.
+//
+// [HasClipboardText]: https://wiki.libsdl.org/SDL3/SDL_HasClipboardText
+func HasClipboardText() bool {
+	return sdlHasClipboardText()
+}
+
+// [HasExactlyOneBitSet32] determines if a unsigned 32-bit value has exactly one bit set.
+//
+// [HasExactlyOneBitSet32]: https://wiki.libsdl.org/SDL3/SDL_HasExactlyOneBitSet32
+func HasExactlyOneBitSet32(x uint32) bool {
+	return sdlHasExactlyOneBitSet32(x)
+}
+
+// [HasGamepad] returns whether a gamepad is currently connected.
+//
+// [HasGamepad]: https://wiki.libsdl.org/SDL3/SDL_HasGamepad
+func HasGamepad() bool {
+	return sdlHasGamepad()
+}
+
+// [HasPrimarySelectionText] queries whether the primary selection exists and contains a non-empty text string.
+//
+// [HasPrimarySelectionText]: https://wiki.libsdl.org/SDL3/SDL_HasPrimarySelectionText
+func HasPrimarySelectionText() bool {
+	return sdlHasPrimarySelectionText()
+}
+
+// [InitHapticRumble] initializes a haptic device for simple rumble playback.
+//
+// [InitHapticRumble]: https://wiki.libsdl.org/SDL3/SDL_InitHapticRumble
+func InitHapticRumble(haptic *Haptic) bool {
+	return sdlInitHapticRumble(haptic)
+}
+
+// [InsertGPUDebugLabel] inserts an arbitrary string label into the command buffer callstream.
+//
+// [InsertGPUDebugLabel]: https://wiki.libsdl.org/SDL3/SDL_InsertGPUDebugLabel
+func InsertGPUDebugLabel(commandBuffer *GPUCommandBuffer, text string) {
+	sdlInsertGPUDebugLabel(commandBuffer, text)
+}
+
+// [InsertTrayEntryAt] inserts a tray entry at a given position.
+//
+// [InsertTrayEntryAt]: https://wiki.libsdl.org/SDL3/SDL_InsertTrayEntryAt
+func InsertTrayEntryAt(menu *TrayMenu, pos int32, label string, flags TrayEntryFlags) *TrayEntry {
+	return sdlInsertTrayEntryAt(menu, pos, label, flags)
+}
+
+// [IsAudioDevicePhysical] determines if an audio device is physical (instead of logical).
+//
+// [IsAudioDevicePhysical]: https://wiki.libsdl.org/SDL3/SDL_IsAudioDevicePhysical
+func IsAudioDevicePhysical(devid AudioDeviceID) bool {
+	return sdlIsAudioDevicePhysical(devid)
+}
+
+// [IsAudioDevicePlayback] determines if an audio device is a playback device (instead of recording).
+//
+// [IsAudioDevicePlayback]: https://wiki.libsdl.org/SDL3/SDL_IsAudioDevicePlayback
+func IsAudioDevicePlayback(devid AudioDeviceID) bool {
+	return sdlIsAudioDevicePlayback(devid)
+}
+
+// [IsGamepad] checks if the given joystick is supported by the gamepad interface.
+//
+// [IsGamepad]: https://wiki.libsdl.org/SDL3/SDL_IsGamepad
+func IsGamepad(instanceId JoystickID) bool {
+	return sdlIsGamepad(instanceId)
+}
+
+// [IsJoystickHaptic] queries if a joystick has haptic features.
+//
+// [IsJoystickHaptic]: https://wiki.libsdl.org/SDL3/SDL_IsJoystickHaptic
+func IsJoystickHaptic(joystick *Joystick) bool {
+	return sdlIsJoystickHaptic(joystick)
+}
+
+// [IsJoystickVirtual] queries whether or not a joystick is virtual.
+//
+// [IsJoystickVirtual]: https://wiki.libsdl.org/SDL3/SDL_IsJoystickVirtual
+func IsJoystickVirtual(instanceId JoystickID) bool {
+	return sdlIsJoystickVirtual(instanceId)
+}
+
+// [IsMouseHaptic] queries whether or not the current mouse has haptic capabilities.
+//
+// [IsMouseHaptic]: https://wiki.libsdl.org/SDL3/SDL_IsMouseHaptic
+func IsMouseHaptic() bool {
+	return sdlIsMouseHaptic()
+}
+
+// [IsTV] queries if the current device is a TV.
+//
+// [IsTV]: https://wiki.libsdl.org/SDL3/SDL_IsTV
+func IsTV() bool {
+	return sdlIsTV()
+}
+
+// [IsTablet] queries if the current device is a tablet.
+//
+// [IsTablet]: https://wiki.libsdl.org/SDL3/SDL_IsTablet
+func IsTablet() bool {
+	return sdlIsTablet()
+}
+
+// [LoadPNGIO] loads a PNG image from a seekable SDL data stream.
+//
+// Available since SDL 3.4.0.
+//
+// [LoadPNGIO]: https://wiki.libsdl.org/SDL3/SDL_LoadPNG_IO
+func LoadPNGIO(src *IOStream, closeio bool) *Surface {
+	if sdlLoadPNGIO == nil {
+		notAvailable("SDL_LoadPNG_IO")
+		return nil
+	}
+	return sdlLoadPNGIO(src, closeio)
+}
+
+// [LoadSurfaceIO] loads a BMP or PNG image from a seekable SDL data stream.
+//
+// Available since SDL 3.4.0.
+//
+// [LoadSurfaceIO]: https://wiki.libsdl.org/SDL3/SDL_LoadSurface_IO
+func LoadSurfaceIO(src *IOStream, closeio bool) *Surface {
+	if sdlLoadSurfaceIO == nil {
+		notAvailable("SDL_LoadSurface_IO")
+		return nil
+	}
+	return sdlLoadSurfaceIO(src, closeio)
+}
+
+// [LockAudioStream] locks an audio stream for serialized access.
+//
+// [LockAudioStream]: https://wiki.libsdl.org/SDL3/SDL_LockAudioStream
+func LockAudioStream(stream *AudioStream) bool {
+	return sdlLockAudioStream(stream)
+}
+
+// [MapRGBA] maps an RGBA quadruple to a pixel value for a given pixel format.
+//
+// [MapRGBA]: https://wiki.libsdl.org/SDL3/SDL_MapRGBA
+func MapRGBA(format *PixelFormatDetails, palette *Palette, r uint8, g uint8, b uint8, a uint8) uint32 {
+	return sdlMapRGBA(format, palette, r, g, b, a)
+}
+
+// [MapSurfaceRGBA] maps an RGBA quadruple to a pixel value for a surface.
+//
+// [MapSurfaceRGBA]: https://wiki.libsdl.org/SDL3/SDL_MapSurfaceRGBA
+func MapSurfaceRGBA(surface *Surface, r uint8, g uint8, b uint8, a uint8) uint32 {
+	return sdlMapSurfaceRGBA(surface, r, g, b, a)
+}
+
+// [MetalCreateView] creates a CAMetalLayer-backed NSView/UIView and attaches it to the specified window.
+//
+// [MetalCreateView]: https://wiki.libsdl.org/SDL3/SDL_Metal_CreateView
+func MetalCreateView(window *Window) MetalView {
+	return sdlMetal_CreateView(window)
+}
+
+// [MetalDestroyView] destroys an existing [MetalView] object.
+//
+// [MetalDestroyView]: https://wiki.libsdl.org/SDL3/SDL_Metal_DestroyView
+func MetalDestroyView(view MetalView) {
+	sdlMetal_DestroyView(view)
+}
+
+// [MetalGetLayer] gets a pointer to the backing CAMetalLayer for the given view.
+//
+// [MetalGetLayer]: https://wiki.libsdl.org/SDL3/SDL_Metal_GetLayer
+func MetalGetLayer(view MetalView) unsafe.Pointer {
+	return sdlMetal_GetLayer(view)
+}
+
+// [MixAudio] mixes audio data in a specified format.
+//
+// [MixAudio]: https://wiki.libsdl.org/SDL3/SDL_MixAudio
+func MixAudio(dst *uint8, src *uint8, format AudioFormat, len uint32, volume float32) bool {
+	return sdlMixAudio(dst, src, format, len, volume)
+}
+
+// [MostSignificantBitIndex32] gets the index of the most significant (set) bit in a 32-bit number.
+//
+// [MostSignificantBitIndex32]: https://wiki.libsdl.org/SDL3/SDL_MostSignificantBitIndex32
+func MostSignificantBitIndex32(x uint32) int32 {
+	return sdlMostSignificantBitIndex32(x)
+}
+
+// [OnApplicationDidEnterBackground] lets iOS apps with external event handling report onApplicationDidEnterBackground.
+//
+// [OnApplicationDidEnterBackground]: https://wiki.libsdl.org/SDL3/SDL_OnApplicationDidEnterBackground
+func OnApplicationDidEnterBackground() {
+	sdlOnApplicationDidEnterBackground()
+}
+
+// [OnApplicationDidEnterForeground] lets iOS apps with external event handling report onApplicationDidBecomeActive.
+//
+// [OnApplicationDidEnterForeground]: https://wiki.libsdl.org/SDL3/SDL_OnApplicationDidEnterForeground
+func OnApplicationDidEnterForeground() {
+	sdlOnApplicationDidEnterForeground()
+}
+
+// [OnApplicationDidReceiveMemoryWarning] lets iOS apps with external event handling report onApplicationDidReceiveMemoryWarning.
+//
+// [OnApplicationDidReceiveMemoryWarning]: https://wiki.libsdl.org/SDL3/SDL_OnApplicationDidReceiveMemoryWarning
+func OnApplicationDidReceiveMemoryWarning() {
+	sdlOnApplicationDidReceiveMemoryWarning()
+}
+
+// [OnApplicationWillEnterBackground] lets iOS apps with external event handling report onApplicationWillResignActive.
+//
+// [OnApplicationWillEnterBackground]: https://wiki.libsdl.org/SDL3/SDL_OnApplicationWillEnterBackground
+func OnApplicationWillEnterBackground() {
+	sdlOnApplicationWillEnterBackground()
+}
+
+// [OnApplicationWillEnterForeground] lets iOS apps with external event handling report onApplicationWillEnterForeground.
+//
+// [OnApplicationWillEnterForeground]: https://wiki.libsdl.org/SDL3/SDL_OnApplicationWillEnterForeground
+func OnApplicationWillEnterForeground() {
+	sdlOnApplicationWillEnterForeground()
+}
+
+// [OnApplicationWillTerminate] lets iOS apps with external event handling report onApplicationWillTerminate.
+//
+// [OnApplicationWillTerminate]: https://wiki.libsdl.org/SDL3/SDL_OnApplicationWillTerminate
+func OnApplicationWillTerminate() {
+	sdlOnApplicationWillTerminate()
+}
+
+// [OpenAudioDevice] opens a specific audio device.
+//
+// [OpenAudioDevice]: https://wiki.libsdl.org/SDL3/SDL_OpenAudioDevice
+func OpenAudioDevice(devid AudioDeviceID, spec *AudioSpec) AudioDeviceID {
+	return sdlOpenAudioDevice(devid, spec)
+}
+
+// [OpenHaptic] opens a haptic device for use.
+//
+// [OpenHaptic]: https://wiki.libsdl.org/SDL3/SDL_OpenHaptic
+func OpenHaptic(instanceId HapticID) *Haptic {
+	return sdlOpenHaptic(instanceId)
+}
+
+// [OpenHapticFromJoystick] opens a haptic device for use from a joystick device.
+//
+// [OpenHapticFromJoystick]: https://wiki.libsdl.org/SDL3/SDL_OpenHapticFromJoystick
+func OpenHapticFromJoystick(joystick *Joystick) *Haptic {
+	return sdlOpenHapticFromJoystick(joystick)
+}
+
+// [OpenHapticFromMouse] tries to open a haptic device from the current mouse.
+//
+// [OpenHapticFromMouse]: https://wiki.libsdl.org/SDL3/SDL_OpenHapticFromMouse
+func OpenHapticFromMouse() *Haptic {
+	return sdlOpenHapticFromMouse()
+}
+
+// [OpenSensor] opens a sensor for use.
+//
+// [OpenSensor]: https://wiki.libsdl.org/SDL3/SDL_OpenSensor
+func OpenSensor(instanceId SensorID) *Sensor {
+	return sdlOpenSensor(instanceId)
+}
+
+// [OutOfMemory] sets an error indicating that memory allocation failed.
+//
+// [OutOfMemory]: https://wiki.libsdl.org/SDL3/SDL_OutOfMemory
+func OutOfMemory() bool {
+	return sdlOutOfMemory()
+}
+
+// [PauseAudioDevice] uses this function to pause audio playback on a specified device.
+//
+// [PauseAudioDevice]: https://wiki.libsdl.org/SDL3/SDL_PauseAudioDevice
+func PauseAudioDevice(dev AudioDeviceID) bool {
+	return sdlPauseAudioDevice(dev)
+}
+
+// [PauseHaptic] pauses a haptic device.
+//
+// [PauseHaptic]: https://wiki.libsdl.org/SDL3/SDL_PauseHaptic
+func PauseHaptic(haptic *Haptic) bool {
+	return sdlPauseHaptic(haptic)
+}
+
+// [PlayHapticRumble] runs a simple rumble effect on a haptic device.
+//
+// [PlayHapticRumble]: https://wiki.libsdl.org/SDL3/SDL_PlayHapticRumble
+func PlayHapticRumble(haptic *Haptic, strength float32, length uint32) bool {
+	return sdlPlayHapticRumble(haptic, strength, length)
+}
+
+// [PopGPUDebugGroup] ends the most-recently pushed debug group.
+//
+// [PopGPUDebugGroup]: https://wiki.libsdl.org/SDL3/SDL_PopGPUDebugGroup
+func PopGPUDebugGroup(commandBuffer *GPUCommandBuffer) {
+	sdlPopGPUDebugGroup(commandBuffer)
+}
+
+// [PremultiplyAlpha] premultiplies the alpha on a block of pixels.
+//
+// [PremultiplyAlpha]: https://wiki.libsdl.org/SDL3/SDL_PremultiplyAlpha
+func PremultiplyAlpha(width int32, height int32, srcFormat PixelFormat, src unsafe.Pointer, srcPitch int32, dstFormat PixelFormat, dst unsafe.Pointer, dstPitch int32, linear bool) bool {
+	return sdlPremultiplyAlpha(width, height, srcFormat, src, srcPitch, dstFormat, dst, dstPitch, linear)
+}
+
+// [PremultiplySurfaceAlpha] premultiplies the alpha in a surface.
+//
+// [PremultiplySurfaceAlpha]: https://wiki.libsdl.org/SDL3/SDL_PremultiplySurfaceAlpha
+func PremultiplySurfaceAlpha(surface *Surface, linear bool) bool {
+	return sdlPremultiplySurfaceAlpha(surface, linear)
+}
+
+// [PushGPUComputeUniformData] pushes data to a uniform slot on the command buffer.
+//
+// [PushGPUComputeUniformData]: https://wiki.libsdl.org/SDL3/SDL_PushGPUComputeUniformData
+func PushGPUComputeUniformData(commandBuffer *GPUCommandBuffer, slotIndex uint32, data unsafe.Pointer, length uint32) {
+	sdlPushGPUComputeUniformData(commandBuffer, slotIndex, data, length)
+}
+
+// [PushGPUDebugGroup] begins a debug group with an arbitrary name.
+//
+// [PushGPUDebugGroup]: https://wiki.libsdl.org/SDL3/SDL_PushGPUDebugGroup
+func PushGPUDebugGroup(commandBuffer *GPUCommandBuffer, name string) {
+	sdlPushGPUDebugGroup(commandBuffer, name)
+}
+
+// [QueryGPUFence] checks the status of a fence.
+//
+// [QueryGPUFence]: https://wiki.libsdl.org/SDL3/SDL_QueryGPUFence
+func QueryGPUFence(device *GPUDevice, fence *GPUFence) bool {
+	return sdlQueryGPUFence(device, fence)
+}
+
+// [ReadSurfacePixel] retrieves a single pixel from a surface.
+//
+// [ReadSurfacePixel]: https://wiki.libsdl.org/SDL3/SDL_ReadSurfacePixel
+func ReadSurfacePixel(surface *Surface, x int32, y int32, r *uint8, g *uint8, b *uint8, a *uint8) bool {
+	return sdlReadSurfacePixel(surface, x, y, r, g, b, a)
+}
+
+// [ReadSurfacePixelFloat] retrieves a single pixel from a surface.
+//
+// [ReadSurfacePixelFloat]: https://wiki.libsdl.org/SDL3/SDL_ReadSurfacePixelFloat
+func ReadSurfacePixelFloat(surface *Surface, x int32, y int32, r *float32, g *float32, b *float32, a *float32) bool {
+	return sdlReadSurfacePixelFloat(surface, x, y, r, g, b, a)
+}
+
+// [ReleaseGPUComputePipeline] frees the given compute pipeline as soon as it is safe to do so.
+//
+// [ReleaseGPUComputePipeline]: https://wiki.libsdl.org/SDL3/SDL_ReleaseGPUComputePipeline
+func ReleaseGPUComputePipeline(device *GPUDevice, computePipeline *GPUComputePipeline) {
+	sdlReleaseGPUComputePipeline(device, computePipeline)
+}
+
+// [ReleaseGPUFence] releases a fence obtained from [SubmitGPUCommandBufferAndAcquireFence].
+//
+// [ReleaseGPUFence]: https://wiki.libsdl.org/SDL3/SDL_ReleaseGPUFence
+func ReleaseGPUFence(device *GPUDevice, fence *GPUFence) {
+	sdlReleaseGPUFence(device, fence)
+}
+
+// [ReloadGamepadMappings] reinitializes the SDL mapping database to its initial state.
+//
+// [ReloadGamepadMappings]: https://wiki.libsdl.org/SDL3/SDL_ReloadGamepadMappings
+func ReloadGamepadMappings() bool {
+	return sdlReloadGamepadMappings()
+}
+
+// [RemoveTrayEntry] removes a tray entry.
+//
+// [RemoveTrayEntry]: https://wiki.libsdl.org/SDL3/SDL_RemoveTrayEntry
+func RemoveTrayEntry(entry *TrayEntry) {
+	sdlRemoveTrayEntry(entry)
+}
+
+// [ResumeAudioDevice] uses this function to unpause audio playback on a specified device.
+//
+// [ResumeAudioDevice]: https://wiki.libsdl.org/SDL3/SDL_ResumeAudioDevice
+func ResumeAudioDevice(dev AudioDeviceID) bool {
+	return sdlResumeAudioDevice(dev)
+}
+
+// [ResumeHaptic] resumes a haptic device.
+//
+// [ResumeHaptic]: https://wiki.libsdl.org/SDL3/SDL_ResumeHaptic
+func ResumeHaptic(haptic *Haptic) bool {
+	return sdlResumeHaptic(haptic)
+}
+
+// [RumbleGamepad] starts a rumble effect on a gamepad.
+//
+// [RumbleGamepad]: https://wiki.libsdl.org/SDL3/SDL_RumbleGamepad
+func RumbleGamepad(gamepad *Gamepad, lowFrequencyRumble uint16, highFrequencyRumble uint16, durationMs uint32) bool {
+	return sdlRumbleGamepad(gamepad, lowFrequencyRumble, highFrequencyRumble, durationMs)
+}
+
+// [RumbleGamepadTriggers] starts a rumble effect in the gamepad's triggers.
+//
+// [RumbleGamepadTriggers]: https://wiki.libsdl.org/SDL3/SDL_RumbleGamepadTriggers
+func RumbleGamepadTriggers(gamepad *Gamepad, leftRumble uint16, rightRumble uint16, durationMs uint32) bool {
+	return sdlRumbleGamepadTriggers(gamepad, leftRumble, rightRumble, durationMs)
+}
+
+// [RunHapticEffect] runs the haptic effect on its associated haptic device.
+//
+// [RunHapticEffect]: https://wiki.libsdl.org/SDL3/SDL_RunHapticEffect
+func RunHapticEffect(haptic *Haptic, effect int32, iterations uint32) bool {
+	return sdlRunHapticEffect(haptic, effect, iterations)
+}
+
+// [SavePNGIO] saves a surface to a seekable SDL data stream in PNG format.
+//
+// Available since SDL 3.4.0.
+//
+// [SavePNGIO]: https://wiki.libsdl.org/SDL3/SDL_SavePNG_IO
+func SavePNGIO(surface *Surface, dst *IOStream, closeio bool) bool {
+	if sdlSavePNGIO == nil {
+		notAvailable("SDL_SavePNG_IO")
+		return false
+	}
+	return sdlSavePNGIO(surface, dst, closeio)
+}
+
+// [SendGamepadEffect] sends a gamepad specific effect packet.
+//
+// [SendGamepadEffect]: https://wiki.libsdl.org/SDL3/SDL_SendGamepadEffect
+func SendGamepadEffect(gamepad *Gamepad, data unsafe.Pointer, size int32) bool {
+	return sdlSendGamepadEffect(gamepad, data, size)
+}
+
+// [SendJoystickVirtualSensorData] sends a sensor update for an opened virtual joystick.
+//
+// [SendJoystickVirtualSensorData]: https://wiki.libsdl.org/SDL3/SDL_SendJoystickVirtualSensorData
+func SendJoystickVirtualSensorData(joystick *Joystick, sensorType SensorType, sensorTimestamp uint64, data *float32, numValues int32) bool {
+	return sdlSendJoystickVirtualSensorData(joystick, sensorType, sensorTimestamp, data, numValues)
+}
+
+// [SetAppMetadata] specifies basic metadata about your app.
+//
+// [SetAppMetadata]: https://wiki.libsdl.org/SDL3/SDL_SetAppMetadata
+func SetAppMetadata(appname string, appversion string, appidentifier string) bool {
+	return sdlSetAppMetadata(appname, appversion, appidentifier)
+}
+
+// [SetAppMetadataProperty] specifies metadata about your app through a set of properties.
+//
+// [SetAppMetadataProperty]: https://wiki.libsdl.org/SDL3/SDL_SetAppMetadataProperty
+func SetAppMetadataProperty(name string, value string) bool {
+	return sdlSetAppMetadataProperty(name, value)
+}
+
+// [SetAudioDeviceGain] changes the gain of an audio device.
+//
+// [SetAudioDeviceGain]: https://wiki.libsdl.org/SDL3/SDL_SetAudioDeviceGain
+func SetAudioDeviceGain(devid AudioDeviceID, gain float32) bool {
+	return sdlSetAudioDeviceGain(devid, gain)
+}
+
+// [SetAudioPostmixCallback] sets a callback that fires when data is about to be fed to an audio device.
+//
+// [SetAudioPostmixCallback]: https://wiki.libsdl.org/SDL3/SDL_SetAudioPostmixCallback
+func SetAudioPostmixCallback(devid AudioDeviceID, callback AudioPostmixCallback, userdata unsafe.Pointer) bool {
+	return sdlSetAudioPostmixCallback(devid, callback, userdata)
+}
+
+// [SetAudioStreamGetCallback] sets a callback that runs when data is requested from an audio stream.
+//
+// [SetAudioStreamGetCallback]: https://wiki.libsdl.org/SDL3/SDL_SetAudioStreamGetCallback
+func SetAudioStreamGetCallback(stream *AudioStream, callback AudioStreamCallback, userdata unsafe.Pointer) bool {
+	return sdlSetAudioStreamGetCallback(stream, callback, userdata)
+}
+
+// [SetAudioStreamInputChannelMap] sets the current input channel map of an audio stream.
+//
+// [SetAudioStreamInputChannelMap]: https://wiki.libsdl.org/SDL3/SDL_SetAudioStreamInputChannelMap
+func SetAudioStreamInputChannelMap(stream *AudioStream, chmap *int32, count int32) bool {
+	return sdlSetAudioStreamInputChannelMap(stream, chmap, count)
+}
+
+// [SetAudioStreamOutputChannelMap] sets the current output channel map of an audio stream.
+//
+// [SetAudioStreamOutputChannelMap]: https://wiki.libsdl.org/SDL3/SDL_SetAudioStreamOutputChannelMap
+func SetAudioStreamOutputChannelMap(stream *AudioStream, chmap *int32, count int32) bool {
+	return sdlSetAudioStreamOutputChannelMap(stream, chmap, count)
+}
+
+// [SetAudioStreamPutCallback] sets a callback that runs when data is added to an audio stream.
+//
+// [SetAudioStreamPutCallback]: https://wiki.libsdl.org/SDL3/SDL_SetAudioStreamPutCallback
+func SetAudioStreamPutCallback(stream *AudioStream, callback AudioStreamCallback, userdata unsafe.Pointer) bool {
+	return sdlSetAudioStreamPutCallback(stream, callback, userdata)
+}
+
+// [SetClipboardData] offers clipboard data to the OS.
+//
+// [SetClipboardData]: https://wiki.libsdl.org/SDL3/SDL_SetClipboardData
+func SetClipboardData(callback ClipboardDataCallback, cleanup ClipboardCleanupCallback, userdata unsafe.Pointer, mimeTypes **byte, numMimeTypes uint64) bool {
+	return sdlSetClipboardData(callback, cleanup, userdata, mimeTypes, numMimeTypes)
+}
+
+// [SetClipboardText] puts UTF-8 text into the clipboard.
+//
+// [SetClipboardText]: https://wiki.libsdl.org/SDL3/SDL_SetClipboardText
+func SetClipboardText(text string) bool {
+	return sdlSetClipboardText(text)
+}
+
+// [SetGPUAllowedFramesInFlight] configures the maximum allowed number of frames in flight.
+//
+// [SetGPUAllowedFramesInFlight]: https://wiki.libsdl.org/SDL3/SDL_SetGPUAllowedFramesInFlight
+func SetGPUAllowedFramesInFlight(device *GPUDevice, allowedFramesInFlight uint32) bool {
+	return sdlSetGPUAllowedFramesInFlight(device, allowedFramesInFlight)
+}
+
+// [SetGPURenderState] sets custom GPU render state.
+//
+// Available since SDL 3.4.0.
+//
+// [SetGPURenderState]: https://wiki.libsdl.org/SDL3/SDL_SetGPURenderState
+func SetGPURenderState(renderer *Renderer, state *GPURenderState) bool {
+	if sdlSetGPURenderState == nil {
+		notAvailable("SDL_SetGPURenderState")
+		return false
+	}
+	return sdlSetGPURenderState(renderer, state)
+}
+
+// [SetGPURenderStateFragmentUniforms] sets fragment shader uniform variables in a custom GPU render state.
+//
+// Available since SDL 3.4.0.
+//
+// [SetGPURenderStateFragmentUniforms]: https://wiki.libsdl.org/SDL3/SDL_SetGPURenderStateFragmentUniforms
+func SetGPURenderStateFragmentUniforms(state *GPURenderState, slotIndex uint32, data uintptr, length uint32) bool {
+	if sdlSetGPURenderStateFragmentUniforms == nil {
+		notAvailable("SDL_SetGPURenderStateFragmentUniforms")
+		return false
+	}
+	return sdlSetGPURenderStateFragmentUniforms(state, slotIndex, data, length)
+}
+
+// [SetGPUStencilReference] sets the current stencil reference value on a command buffer.
+//
+// [SetGPUStencilReference]: https://wiki.libsdl.org/SDL3/SDL_SetGPUStencilReference
+func SetGPUStencilReference(renderPass *GPURenderPass, reference uint8) {
+	sdlSetGPUStencilReference(renderPass, reference)
+}
+
+// [SetGPUTextureName] sets an arbitrary string constant to label a texture.
+//
+// [SetGPUTextureName]: https://wiki.libsdl.org/SDL3/SDL_SetGPUTextureName
+func SetGPUTextureName(device *GPUDevice, texture *GPUTexture, text string) {
+	sdlSetGPUTextureName(device, texture, text)
+}
+
+// [SetGamepadEventsEnabled] sets the state of gamepad event processing.
+//
+// [SetGamepadEventsEnabled]: https://wiki.libsdl.org/SDL3/SDL_SetGamepadEventsEnabled
+func SetGamepadEventsEnabled(enabled bool) {
+	sdlSetGamepadEventsEnabled(enabled)
+}
+
+// [SetGamepadLED] updates a gamepad's LED color.
+//
+// [SetGamepadLED]: https://wiki.libsdl.org/SDL3/SDL_SetGamepadLED
+func SetGamepadLED(gamepad *Gamepad, red uint8, green uint8, blue uint8) bool {
+	return sdlSetGamepadLED(gamepad, red, green, blue)
+}
+
+// [SetGamepadMapping] sets the current mapping of a joystick or gamepad.
+//
+// [SetGamepadMapping]: https://wiki.libsdl.org/SDL3/SDL_SetGamepadMapping
+func SetGamepadMapping(instanceId JoystickID, mapping string) bool {
+	return sdlSetGamepadMapping(instanceId, mapping)
+}
+
+// [SetGamepadPlayerIndex] sets the player index of an opened gamepad.
+//
+// [SetGamepadPlayerIndex]: https://wiki.libsdl.org/SDL3/SDL_SetGamepadPlayerIndex
+func SetGamepadPlayerIndex(gamepad *Gamepad, playerIndex int32) bool {
+	return sdlSetGamepadPlayerIndex(gamepad, playerIndex)
+}
+
+// [SetGamepadSensorEnabled] sets whether data reporting for a gamepad sensor is enabled.
+//
+// [SetGamepadSensorEnabled]: https://wiki.libsdl.org/SDL3/SDL_SetGamepadSensorEnabled
+func SetGamepadSensorEnabled(gamepad *Gamepad, sensorType SensorType, enabled bool) bool {
+	return sdlSetGamepadSensorEnabled(gamepad, sensorType, enabled)
+}
+
+// [SetHapticAutocenter] sets the global autocenter of the device.
+//
+// [SetHapticAutocenter]: https://wiki.libsdl.org/SDL3/SDL_SetHapticAutocenter
+func SetHapticAutocenter(haptic *Haptic, autocenter int32) bool {
+	return sdlSetHapticAutocenter(haptic, autocenter)
+}
+
+// [SetHapticGain] sets the global gain of the specified haptic device.
+//
+// [SetHapticGain]: https://wiki.libsdl.org/SDL3/SDL_SetHapticGain
+func SetHapticGain(haptic *Haptic, gain int32) bool {
+	return sdlSetHapticGain(haptic, gain)
+}
+
+// [SetJoystickVirtualAxis] sets the state of an axis on an opened virtual joystick.
+//
+// [SetJoystickVirtualAxis]: https://wiki.libsdl.org/SDL3/SDL_SetJoystickVirtualAxis
+func SetJoystickVirtualAxis(joystick *Joystick, axis int32, value int16) bool {
+	return sdlSetJoystickVirtualAxis(joystick, axis, value)
+}
+
+// [SetJoystickVirtualBall] generates ball motion on an opened virtual joystick.
+//
+// [SetJoystickVirtualBall]: https://wiki.libsdl.org/SDL3/SDL_SetJoystickVirtualBall
+func SetJoystickVirtualBall(joystick *Joystick, ball int32, xrel int16, yrel int16) bool {
+	return sdlSetJoystickVirtualBall(joystick, ball, xrel, yrel)
+}
+
+// [SetJoystickVirtualButton] sets the state of a button on an opened virtual joystick.
+//
+// [SetJoystickVirtualButton]: https://wiki.libsdl.org/SDL3/SDL_SetJoystickVirtualButton
+func SetJoystickVirtualButton(joystick *Joystick, button int32, down bool) bool {
+	return sdlSetJoystickVirtualButton(joystick, button, down)
+}
+
+// [SetJoystickVirtualHat] sets the state of a hat on an opened virtual joystick.
+//
+// [SetJoystickVirtualHat]: https://wiki.libsdl.org/SDL3/SDL_SetJoystickVirtualHat
+func SetJoystickVirtualHat(joystick *Joystick, hat int32, value uint8) bool {
+	return sdlSetJoystickVirtualHat(joystick, hat, value)
+}
+
+// [SetJoystickVirtualTouchpad] sets touchpad finger state on an opened virtual joystick.
+//
+// [SetJoystickVirtualTouchpad]: https://wiki.libsdl.org/SDL3/SDL_SetJoystickVirtualTouchpad
+func SetJoystickVirtualTouchpad(joystick *Joystick, touchpad int32, finger int32, down bool, x float32, y float32, pressure float32) bool {
+	return sdlSetJoystickVirtualTouchpad(joystick, touchpad, finger, down, x, y, pressure)
+}
+
+// [SetLinuxThreadPriority] sets the priority for the given thread on Linux.
+//
+// [SetLinuxThreadPriority]: https://wiki.libsdl.org/SDL3/SDL_SetLinuxThreadPriority
+func SetLinuxThreadPriority(threadID int64, priority int32) bool {
+	return sdlSetLinuxThreadPriority(threadID, priority)
+}
+
+// [SetLinuxThreadPriorityAndPolicy] sets the priority and scheduling policy for a thread on Linux.
+//
+// [SetLinuxThreadPriorityAndPolicy]: https://wiki.libsdl.org/SDL3/SDL_SetLinuxThreadPriorityAndPolicy
+func SetLinuxThreadPriorityAndPolicy(threadID int64, sdlPriority int32, schedPolicy int32) bool {
+	return sdlSetLinuxThreadPriorityAndPolicy(threadID, sdlPriority, schedPolicy)
+}
+
+// [SetPrimarySelectionText] puts UTF-8 text into the primary selection.
+//
+// [SetPrimarySelectionText]: https://wiki.libsdl.org/SDL3/SDL_SetPrimarySelectionText
+func SetPrimarySelectionText(text string) bool {
+	return sdlSetPrimarySelectionText(text)
+}
+
+// [SetRelativeMouseTransform] sets a user-defined function by which to transform relative mouse inputs.
+//
+// Available since SDL 3.4.0.
+//
+// [SetRelativeMouseTransform]: https://wiki.libsdl.org/SDL3/SDL_SetRelativeMouseTransform
+func SetRelativeMouseTransform(callback MouseMotionTransformCallback, userdata uintptr) bool {
+	if sdlSetRelativeMouseTransform == nil {
+		notAvailable("SDL_SetRelativeMouseTransform")
+		return false
+	}
+	return sdlSetRelativeMouseTransform(callback, userdata)
+}
+
+// [SetTrayEntryCallback] sets a callback to be invoked when the entry is selected.
+//
+// [SetTrayEntryCallback]: https://wiki.libsdl.org/SDL3/SDL_SetTrayEntryCallback
+func SetTrayEntryCallback(entry *TrayEntry, callback TrayCallback, userdata unsafe.Pointer) {
+	sdlSetTrayEntryCallback(entry, callback, userdata)
+}
+
+// [SetTrayEntryChecked] sets whether or not an entry is checked.
+//
+// [SetTrayEntryChecked]: https://wiki.libsdl.org/SDL3/SDL_SetTrayEntryChecked
+func SetTrayEntryChecked(entry *TrayEntry, checked bool) {
+	sdlSetTrayEntryChecked(entry, checked)
+}
+
+// [SetTrayEntryEnabled] sets whether or not an entry is enabled.
+//
+// [SetTrayEntryEnabled]: https://wiki.libsdl.org/SDL3/SDL_SetTrayEntryEnabled
+func SetTrayEntryEnabled(entry *TrayEntry, enabled bool) {
+	sdlSetTrayEntryEnabled(entry, enabled)
+}
+
+// [SetTrayEntryLabel] sets the label of an entry.
+//
+// [SetTrayEntryLabel]: https://wiki.libsdl.org/SDL3/SDL_SetTrayEntryLabel
+func SetTrayEntryLabel(entry *TrayEntry, label string) {
+	sdlSetTrayEntryLabel(entry, label)
+}
+
+// [SetTrayIcon] updates the system tray icon's icon.
+//
+// [SetTrayIcon]: https://wiki.libsdl.org/SDL3/SDL_SetTrayIcon
+func SetTrayIcon(tray *Tray, icon *Surface) {
+	sdlSetTrayIcon(tray, icon)
+}
+
+// [SetTrayTooltip] updates the system tray icon's tooltip.
+//
+// [SetTrayTooltip]: https://wiki.libsdl.org/SDL3/SDL_SetTrayTooltip
+func SetTrayTooltip(tray *Tray, tooltip string) {
+	sdlSetTrayTooltip(tray, tooltip)
+}
+
+// [SetWindowFillDocument] sets the window to fill the current document space (Emscripten only).
+//
+// Available since SDL 3.4.0.
+//
+// [SetWindowFillDocument]: https://wiki.libsdl.org/SDL3/SDL_SetWindowFillDocument
+func SetWindowFillDocument(window *Window, fill bool) bool {
+	if sdlSetWindowFillDocument == nil {
+		notAvailable("SDL_SetWindowFillDocument")
+		return false
+	}
+	return sdlSetWindowFillDocument(window, fill)
+}
+
+// [SetWindowShape] sets the shape of a transparent window.
+//
+// [SetWindowShape]: https://wiki.libsdl.org/SDL3/SDL_SetWindowShape
+func SetWindowShape(window *Window, shape *Surface) bool {
+	return sdlSetWindowShape(window, shape)
+}
+
+// [SetX11EventHook] sets a callback for every X11 event.
+//
+// [SetX11EventHook]: https://wiki.libsdl.org/SDL3/SDL_SetX11EventHook
+func SetX11EventHook(callback X11EventHook, userdata unsafe.Pointer) {
+	sdlSetX11EventHook(callback, userdata)
+}
+
+// [StopHapticEffect] stops the haptic effect on its associated haptic device.
+//
+// [StopHapticEffect]: https://wiki.libsdl.org/SDL3/SDL_StopHapticEffect
+func StopHapticEffect(haptic *Haptic, effect int32) bool {
+	return sdlStopHapticEffect(haptic, effect)
+}
+
+// [StopHapticEffects] stops all the currently playing effects on a haptic device.
+//
+// [StopHapticEffects]: https://wiki.libsdl.org/SDL3/SDL_StopHapticEffects
+func StopHapticEffects(haptic *Haptic) bool {
+	return sdlStopHapticEffects(haptic)
+}
+
+// [StopHapticRumble] stops the simple rumble on a haptic device.
+//
+// [StopHapticRumble]: https://wiki.libsdl.org/SDL3/SDL_StopHapticRumble
+func StopHapticRumble(haptic *Haptic) bool {
+	return sdlStopHapticRumble(haptic)
+}
+
+// [SubmitGPUCommandBufferAndAcquireFence] submits a command buffer so its commands can be processed on the GPU, and acquires a fence associated with the command buffer.
+//
+// [SubmitGPUCommandBufferAndAcquireFence]: https://wiki.libsdl.org/SDL3/SDL_SubmitGPUCommandBufferAndAcquireFence
+func SubmitGPUCommandBufferAndAcquireFence(commandBuffer *GPUCommandBuffer) *GPUFence {
+	return sdlSubmitGPUCommandBufferAndAcquireFence(commandBuffer)
+}
+
+// [SwapFloat] byte-swaps a floating point number.
+//
+// [SwapFloat]: https://wiki.libsdl.org/SDL3/SDL_SwapFloat
+func SwapFloat(x float32) float32 {
+	return sdlSwapFloat(x)
+}
+
+// [TimeFromWindows] converts a Windows FILETIME (100-nanosecond intervals since January 1, 1601) to an SDL time.
+//
+// [TimeFromWindows]: https://wiki.libsdl.org/SDL3/SDL_TimeFromWindows
+func TimeFromWindows(dwLowDateTime uint32, dwHighDateTime uint32) Time {
+	return sdlTimeFromWindows(dwLowDateTime, dwHighDateTime)
+}
+
+// [TimeToWindows] converts an SDL time into a Windows FILETIME (100-nanosecond intervals since January 1, 1601).
+//
+// [TimeToWindows]: https://wiki.libsdl.org/SDL3/SDL_TimeToWindows
+func TimeToWindows(ticks Time, dwLowDateTime *uint32, dwHighDateTime *uint32) {
+	sdlTimeToWindows(ticks, dwLowDateTime, dwHighDateTime)
+}
+
+// [UnbindAudioStream] unbinds a single audio stream from its audio device.
+//
+// [UnbindAudioStream]: https://wiki.libsdl.org/SDL3/SDL_UnbindAudioStream
+func UnbindAudioStream(stream *AudioStream) {
+	sdlUnbindAudioStream(stream)
+}
+
+// [UnbindAudioStreams] unbinds a list of audio streams from their audio devices.
+//
+// [UnbindAudioStreams]: https://wiki.libsdl.org/SDL3/SDL_UnbindAudioStreams
+func UnbindAudioStreams(streams **AudioStream, numStreams int32) {
+	sdlUnbindAudioStreams(streams, numStreams)
+}
+
+// [UnlockAudioStream] unlocks an audio stream for serialized access.
+//
+// [UnlockAudioStream]: https://wiki.libsdl.org/SDL3/SDL_UnlockAudioStream
+func UnlockAudioStream(stream *AudioStream) bool {
+	return sdlUnlockAudioStream(stream)
+}
+
+// [UpdateGamepads] manually pumps gamepad updates if not using the loop.
+//
+// [UpdateGamepads]: https://wiki.libsdl.org/SDL3/SDL_UpdateGamepads
+func UpdateGamepads() {
+	sdlUpdateGamepads()
+}
+
+// [UpdateHapticEffect] updates the properties of an effect.
+//
+// [UpdateHapticEffect]: https://wiki.libsdl.org/SDL3/SDL_UpdateHapticEffect
+func UpdateHapticEffect(haptic *Haptic, effect int32, data *HapticEffect) bool {
+	return sdlUpdateHapticEffect(haptic, effect, data)
+}
+
+// [UpdateSensors] updates the current state of the open sensors.
+//
+// [UpdateSensors]: https://wiki.libsdl.org/SDL3/SDL_UpdateSensors
+func UpdateSensors() {
+	sdlUpdateSensors()
+}
+
+// [UpdateWindowSurfaceRects] copies areas of the window surface to the screen.
+//
+// [UpdateWindowSurfaceRects]: https://wiki.libsdl.org/SDL3/SDL_UpdateWindowSurfaceRects
+func UpdateWindowSurfaceRects(window *Window, rects *Rect, numrects int32) bool {
+	return sdlUpdateWindowSurfaceRects(window, rects, numrects)
+}
+
+// [WaitForGPUFences] blocks the thread until the given fences are signaled.
+//
+// [WaitForGPUFences]: https://wiki.libsdl.org/SDL3/SDL_WaitForGPUFences
+func WaitForGPUFences(device *GPUDevice, waitAll bool, fences **GPUFence, numFences uint32) bool {
+	return sdlWaitForGPUFences(device, waitAll, fences, numFences)
+}
+
+// [WaitForGPUIdle] blocks the thread until the GPU is completely idle.
+//
+// [WaitForGPUIdle]: https://wiki.libsdl.org/SDL3/SDL_WaitForGPUIdle
+func WaitForGPUIdle(device *GPUDevice) bool {
+	return sdlWaitForGPUIdle(device)
+}
+
+// [WaitForGPUSwapchain] blocks the thread until a swapchain texture is available to be acquired.
+//
+// [WaitForGPUSwapchain]: https://wiki.libsdl.org/SDL3/SDL_WaitForGPUSwapchain
+func WaitForGPUSwapchain(device *GPUDevice, window *Window) bool {
+	return sdlWaitForGPUSwapchain(device, window)
+}
+
+// [WasInit] returns a mask of the specified subsystems which are currently initialized.
+//
+// [WasInit]: https://wiki.libsdl.org/SDL3/SDL_WasInit
+func WasInit(flags InitFlags) InitFlags {
+	return sdlWasInit(flags)
+}
+
+// [WindowSupportsGPUSwapchainComposition] determines whether a swapchain composition is supported by the window.
+//
+// [WindowSupportsGPUSwapchainComposition]: https://wiki.libsdl.org/SDL3/SDL_WindowSupportsGPUSwapchainComposition
+func WindowSupportsGPUSwapchainComposition(device *GPUDevice, window *Window, swapchainComposition GPUSwapchainComposition) bool {
+	return sdlWindowSupportsGPUSwapchainComposition(device, window, swapchainComposition)
+}
+
+// [WriteSurfacePixel] writes a single pixel to a surface.
+//
+// [WriteSurfacePixel]: https://wiki.libsdl.org/SDL3/SDL_WriteSurfacePixel
+func WriteSurfacePixel(surface *Surface, x int32, y int32, r uint8, g uint8, b uint8, a uint8) bool {
+	return sdlWriteSurfacePixel(surface, x, y, r, g, b, a)
+}
+
+// [WriteSurfacePixelFloat] writes a single pixel to a surface.
+//
+// [WriteSurfacePixelFloat]: https://wiki.libsdl.org/SDL3/SDL_WriteSurfacePixelFloat
+func WriteSurfacePixelFloat(surface *Surface, x int32, y int32, r float32, g float32, b float32, a float32) bool {
+	return sdlWriteSurfacePixelFloat(surface, x, y, r, g, b, a)
+}
@@ -0,0 +1,171 @@
+package sdltest
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"unsafe"
+
+	"github.com/jupiterrider/purego-sdl3/sdl"
+)
+
+// EnvUpdateGolden names the environment variable that makes [Env.AssertGolden] write the golden images
+// instead of comparing against them.
+const EnvUpdateGolden = "SDLTEST_UPDATE_GOLDEN"
+
+// Tolerance limits the differences accepted by [Compare].
+type Tolerance struct {
+	// Channel is the largest accepted difference of a single color channel of a pixel.
+	Channel uint8
+	// Pixels is the fraction (0 to 1) of pixels allowed to exceed Channel.
+	Pixels float64
+}
+
+// ReadPixels reads the current render target of the environment's renderer, see [ReadPixels].
+func (env *Env) ReadPixels() (*image.NRGBA, error) {
+	return ReadPixels(env.Renderer, nil)
+}
+
+// ReadPixels reads pixels of the current render target with [sdl.RenderReadPixels] and converts them into an image.
+// SDL pixels are not premultiplied by alpha, so the image is an [image.NRGBA].
+// A nil rect reads the whole target.
+//
+// It must be called before [sdl.RenderPresent], which may clear the back buffer.
+func ReadPixels(renderer *sdl.Renderer, rect *sdl.Rect) (*image.NRGBA, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	surface := sdl.RenderReadPixels(renderer, rect)
+	if surface == nil {
+		return nil, sdl.NewError("SDL_RenderReadPixels")
+	}
+	defer sdl.DestroySurface(surface)
+
+	converted := sdl.ConvertSurface(surface, sdl.PixelFormatRGBA32)
+	if converted == nil {
+		return nil, sdl.NewError("SDL_ConvertSurface")
+	}
+	defer sdl.DestroySurface(converted)
+
+	if sdl.MustLock(converted) && !sdl.LockSurface(converted) {
+		return nil, sdl.NewError("SDL_LockSurface")
+	}
+	defer sdl.UnlockSurface(converted)
+
+	img := image.NewNRGBA(image.Rect(0, 0, int(converted.W), int(converted.H)))
+	pixels := unsafe.Slice((*byte)(converted.Pixels), int(converted.Pitch)*int(converted.H))
+	for y := 0; y < int(converted.H); y++ {
+		row := pixels[y*int(converted.Pitch):]
+		copy(img.Pix[y*img.Stride:(y+1)*img.Stride], row[:img.Stride])
+	}
+	return img, nil
+}
+
+// Compare compares got with want and returns an error describing the differences that exceed tol.
+func Compare(got, want image.Image, tol Tolerance) error {
+	gb, wb := got.Bounds(), want.Bounds()
+	if gb.Dx() != wb.Dx() || gb.Dy() != wb.Dy() {
+		return fmt.Errorf("sdltest: image size %dx%d, want %dx%d", gb.Dx(), gb.Dy(), wb.Dx(), wb.Dy())
+	}
+
+	var differing int
+	var first image.Point
+	var firstGot, firstWant color.NRGBA
+	for y := 0; y < gb.Dy(); y++ {
+		for x := 0; x < gb.Dx(); x++ {
+			g := color.NRGBAModel.Convert(got.At(gb.Min.X+x, gb.Min.Y+y)).(color.NRGBA)
+			w := color.NRGBAModel.Convert(want.At(wb.Min.X+x, wb.Min.Y+y)).(color.NRGBA)
+			if diff(g.R, w.R) > tol.Channel || diff(g.G, w.G) > tol.Channel ||
+				diff(g.B, w.B) > tol.Channel || diff(g.A, w.A) > tol.Channel {
+				if differing == 0 {
+					first, firstGot, firstWant = image.Pt(x, y), g, w
+				}
+				differing++
+			}
+		}
+	}
+
+	total := gb.Dx() * gb.Dy()
+	if differing == 0 || float64(differing) <= tol.Pixels*float64(total) {
+		return nil
+	}
+	return fmt.Errorf("sdltest: %d of %d pixels differ, first at %v: got %v, want %v",
+		differing, total, first, firstGot, firstWant)
+}
+
+// AssertGolden reads the render target and compares it with the PNG image at path, failing the test on differences.
+//
+// If the environment variable SDLTEST_UPDATE_GOLDEN is set to a non-empty value, the image is written to path instead.
+// On a mismatch, the rendered image is saved next to the golden image with the suffix ".got.png" for inspection.
+func (env *Env) AssertGolden(tb testing.TB, path string, tol Tolerance) {
+	tb.Helper()
+
+	got, err := env.ReadPixels()
+	if err != nil {
+		tb.Fatalf("sdltest: %v", err)
+	}
+
+	if os.Getenv(EnvUpdateGolden) != "" {
+		if err := writePNG(path, got); err != nil {
+			tb.Fatalf("sdltest: %v", err)
+		}
+		return
+	}
+
+	want, err := readPNG(path)
+	if errors.Is(err, os.ErrNotExist) {
+		tb.Fatalf("sdltest: golden image %s does not exist, set %s=1 to create it", path, EnvUpdateGolden)
+	} else if err != nil {
+		tb.Fatalf("sdltest: %v", err)
+	}
+
+	if err := Compare(got, want, tol); err != nil {
+		actual := path[:len(path)-len(filepath.Ext(path))] + ".got.png"
+		if werr := writePNG(actual, got); werr == nil {
+			tb.Errorf("%v (rendered image saved to %s)", err, actual)
+		} else {
+			tb.Error(err)
+		}
+	}
+}
+
+func readPNG(path string) (image.Image, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	img, err := png.Decode(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return img, nil
+}
+
+func writePNG(path string, img image.Image) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := png.Encode(f, img); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func diff(a, b uint8) uint8 {
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
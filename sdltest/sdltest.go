@@ -0,0 +1,172 @@
+// Package sdltest runs SDL in tests without a display, GPU or sound card.
+//
+// [New] initializes SDL with the offscreen (or dummy) video driver, the dummy (or disk) audio driver
+// and a software renderer, and tears everything down when the test ends:
+//
+//	func TestDraw(t *testing.T) {
+//		env := sdltest.New(t)
+//		sdl.SetRenderDrawColor(env.Renderer, 255, 0, 0, 255)
+//		sdl.RenderClear(env.Renderer)
+//		env.AssertGolden(t, "testdata/red.png", sdltest.Tolerance{})
+//	}
+//
+// Tests are skipped if the SDL library can't be loaded. Set SDLTEST_UPDATE_GOLDEN=1 to (re)write the golden images.
+package sdltest
+
+import (
+	"errors"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/jupiterrider/purego-sdl3/sdl"
+)
+
+// Default drivers, in order of preference.
+const (
+	VideoDrivers  = "offscreen,dummy"
+	AudioDrivers  = "dummy,disk"
+	RenderDrivers = "software"
+)
+
+// Options configures [NewWithOptions]. The zero value of a field selects its default.
+type Options struct {
+	Title  string        // The window title, defaults to the test name.
+	Width  int32         // The window width, defaults to 320.
+	Height int32         // The window height, defaults to 240.
+	Flags  sdl.InitFlags // The subsystems to initialize, defaults to [sdl.InitVideo] | [sdl.InitAudio].
+
+	VideoDriver  string // The value of [sdl.HintVideoDriver], defaults to [VideoDrivers].
+	AudioDriver  string // The value of [sdl.HintAudioDriver], defaults to [AudioDrivers].
+	RenderDriver string // The value of [sdl.HintRenderDriver], defaults to [RenderDrivers].
+
+	// Hints are set before SDL is initialized, in addition to the drivers.
+	Hints map[string]string
+}
+
+// Env is an initialized SDL environment with a window and a software renderer.
+type Env struct {
+	Window   *sdl.Window
+	Renderer *sdl.Renderer
+	Width    int32
+	Height   int32
+}
+
+// SDL keeps global state, so only one environment can exist at a time. Parallel tests are serialized.
+var active sync.Mutex
+
+// owner is the name of the test holding active, to detect a test waiting for itself.
+var owner struct {
+	sync.Mutex
+	name string
+}
+
+// New is like [NewWithOptions] with the default options.
+func New(tb testing.TB) *Env {
+	tb.Helper()
+	return NewWithOptions(tb, Options{})
+}
+
+// NewWithOptions initializes SDL and creates a window with a renderer for the duration of the test.
+//
+// The test is skipped if the SDL library can't be loaded and fails if initialization fails
+// or if [sdl.VerifyABI] reports Go types not matching the C structs. It is also skipped if the struct layouts
+// weren't measured for the platform. It also fails if the test or one of its
+// parents already created an environment, which would never be released while waiting for it.
+// Everything is destroyed and SDL is shut down by a cleanup function registered with tb.
+// The calling goroutine is locked to its thread until then, because SDL expects to be used from a single thread.
+func NewWithOptions(tb testing.TB, opts Options) *Env {
+	tb.Helper()
+
+	if err := sdl.VerifyABI(); errors.Is(err, sdl.ErrABINotMeasured) {
+		tb.Skipf("sdltest: %v", err)
+	} else if err != nil {
+		tb.Fatal(err)
+	}
+	if err := sdl.LoadLibrary(); err != nil {
+		tb.Skipf("sdltest: %v", err)
+	}
+
+	opts.defaults(tb)
+	owner.Lock()
+	name := owner.name
+	owner.Unlock()
+	if name != "" && (name == tb.Name() || strings.HasPrefix(tb.Name(), name+"/")) {
+		// The environment is released when the test ends, so waiting for it would block forever.
+		tb.Fatalf("sdltest: %s already has an environment, only one can exist at a time", name)
+	}
+	active.Lock()
+	owner.Lock()
+	owner.name = tb.Name()
+	owner.Unlock()
+	runtime.LockOSThread()
+
+	env := &Env{Width: opts.Width, Height: opts.Height}
+	tb.Cleanup(func() {
+		env.destroy()
+		sdl.Quit()
+		sdl.ResetHints()
+		runtime.UnlockOSThread()
+		owner.Lock()
+		owner.name = ""
+		owner.Unlock()
+		active.Unlock()
+	})
+
+	sdl.SetHint(sdl.HintVideoDriver, opts.VideoDriver)
+	sdl.SetHint(sdl.HintAudioDriver, opts.AudioDriver)
+	sdl.SetHint(sdl.HintRenderDriver, opts.RenderDriver)
+	for name, value := range opts.Hints {
+		sdl.SetHint(name, value)
+	}
+
+	if err := sdl.InitErr(opts.Flags); err != nil {
+		tb.Fatalf("sdltest: %v", err)
+	}
+	if opts.Flags&sdl.InitVideo == 0 {
+		return env
+	}
+
+	window, renderer, err := sdl.CreateWindowAndRendererErr(opts.Title, opts.Width, opts.Height, sdl.WindowHidden)
+	if err != nil {
+		tb.Fatalf("sdltest: %v", err)
+	}
+	env.Window, env.Renderer = window, renderer
+	return env
+}
+
+func (opts *Options) defaults(tb testing.TB) {
+	if opts.Title == "" {
+		opts.Title = tb.Name()
+	}
+	if opts.Width == 0 {
+		opts.Width = 320
+	}
+	if opts.Height == 0 {
+		opts.Height = 240
+	}
+	if opts.Flags == 0 {
+		opts.Flags = sdl.InitVideo | sdl.InitAudio
+	}
+	if opts.VideoDriver == "" {
+		opts.VideoDriver = VideoDrivers
+	}
+	if opts.AudioDriver == "" {
+		opts.AudioDriver = AudioDrivers
+	}
+	if opts.RenderDriver == "" {
+		opts.RenderDriver = RenderDrivers
+	}
+}
+
+func (env *Env) destroy() {
+	if env.Renderer != nil {
+		sdl.DestroyRenderer(env.Renderer)
+		env.Renderer = nil
+	}
+	if env.Window != nil {
+		sdl.DestroyWindow(env.Window)
+		env.Window = nil
+	}
+}
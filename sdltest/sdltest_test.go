@@ -0,0 +1,90 @@
+package sdltest_test
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/jupiterrider/purego-sdl3/sdl"
+	"github.com/jupiterrider/purego-sdl3/sdltest"
+)
+
+func TestGoldenClear(t *testing.T) {
+	env := sdltest.New(t)
+	sdl.SetRenderDrawColor(env.Renderer, 255, 0, 0, 255)
+	sdl.RenderClear(env.Renderer)
+	env.AssertGolden(t, "testdata/red.png", sdltest.Tolerance{})
+}
+
+// fatalRecorder records the message of Fatalf instead of failing the test.
+type fatalRecorder struct {
+	testing.TB
+	message string
+}
+
+func (r *fatalRecorder) Fatalf(format string, args ...any) {
+	r.message = fmt.Sprintf(format, args...)
+	runtime.Goexit()
+}
+
+func TestNewTwice(t *testing.T) {
+	sdltest.New(t)
+
+	r := &fatalRecorder{TB: t}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		sdltest.New(r)
+	}()
+	<-done
+	if !strings.Contains(r.message, "already has an environment") {
+		t.Fatalf("second New: got %q, want an error", r.message)
+	}
+}
+
+func uniform(w, h int, c color.NRGBA) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for i := 0; i < len(img.Pix); i += 4 {
+		img.Pix[i], img.Pix[i+1], img.Pix[i+2], img.Pix[i+3] = c.R, c.G, c.B, c.A
+	}
+	return img
+}
+
+func TestCompare(t *testing.T) {
+	red := color.NRGBA{255, 0, 0, 255}
+
+	// 4 of 100 pixels differ by 10 in the green channel.
+	spotted := uniform(10, 10, red)
+	for x := 0; x < 4; x++ {
+		spotted.SetNRGBA(x, 0, color.NRGBA{255, 10, 0, 255})
+	}
+
+	tests := []struct {
+		name    string
+		got     image.Image
+		tol     sdltest.Tolerance
+		wantErr string
+	}{
+		{"equal", uniform(10, 10, red), sdltest.Tolerance{}, ""},
+		{"size", uniform(10, 5, red), sdltest.Tolerance{Pixels: 1}, "image size 10x5, want 10x10"},
+		{"channel exceeded", spotted, sdltest.Tolerance{Channel: 9}, "4 of 100 pixels differ, first at (0,0)"},
+		{"channel within", spotted, sdltest.Tolerance{Channel: 10}, ""},
+		{"pixels exceeded", spotted, sdltest.Tolerance{Pixels: 0.03}, "4 of 100 pixels differ"},
+		{"pixels within", spotted, sdltest.Tolerance{Pixels: 0.04}, ""},
+		{"offset bounds", uniform(12, 12, red).SubImage(image.Rect(1, 1, 11, 11)), sdltest.Tolerance{}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := sdltest.Compare(tt.got, uniform(10, 10, red), tt.tol)
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Errorf("got %v, want no error", err)
+			case tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)):
+				t.Errorf("got %v, want an error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
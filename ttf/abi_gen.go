@@ -0,0 +1,49 @@
+// Code generated by bindgen from sdl3_ttf.json. DO NOT EDIT.
+
+package ttf
+
+import (
+	"reflect"
+
+	"github.com/jupiterrider/purego-sdl3/internal/shared"
+)
+
+// abiPointerSize is the size of pointers on the platform the layouts were measured on.
+const abiPointerSize = 8
+
+// abiLayouts are the layouts of the C structs mirrored by Go types, as measured from the SDL3_ttf headers.
+var abiLayouts = []shared.Layout{
+	{
+		Name: "TTF_GPUAtlasDrawSequence", Type: reflect.TypeOf(GPUAtlasDrawSequence{}), Size: 56, Align: 8,
+		Fields: []shared.Field{
+			{Name: "atlas_texture", Go: "AtlasTexture", Offset: 0},
+			{Name: "xy", Go: "XY", Offset: 8},
+			{Name: "uv", Go: "UV", Offset: 16},
+			{Name: "num_vertices", Go: "NumVertices", Offset: 24},
+			{Name: "indices", Go: "Indices", Offset: 32},
+			{Name: "num_indices", Go: "NumIndices", Offset: 40},
+			{Name: "image_type", Go: "ImageType", Offset: 44},
+			{Name: "next", Go: "Next", Offset: 48},
+		},
+	},
+	{
+		Name: "TTF_SubString", Type: reflect.TypeOf(SubString{}), Size: 36, Align: 4,
+		Fields: []shared.Field{
+			{Name: "flags", Go: "Flags", Offset: 0},
+			{Name: "offset", Go: "Offset", Offset: 4},
+			{Name: "length", Go: "Length", Offset: 8},
+			{Name: "line_index", Go: "LineIndex", Offset: 12},
+			{Name: "cluster_index", Go: "ClusterIndex", Offset: 16},
+			{Name: "rect", Go: "Rect", Offset: 20},
+		},
+	},
+	{
+		Name: "TTF_Text", Type: reflect.TypeOf(Text{}), Size: 24, Align: 8,
+		Fields: []shared.Field{
+			{Name: "text", Go: "Text", Offset: 0},
+			{Name: "num_lines", Go: "NumLines", Offset: 8},
+			{Name: "refcount", Go: "Refcount", Offset: 12},
+			{Name: "internal", Go: "Internal", Offset: 16},
+		},
+	},
+}
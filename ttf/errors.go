@@ -0,0 +1,133 @@
+package ttf
+
+import (
+	"runtime"
+
+	"github.com/jupiterrider/purego-sdl3/sdl"
+)
+
+// InitErr is like [Init], but returns an error on failure.
+func InitErr() error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return sdl.Check("TTF_Init", Init())
+}
+
+// OpenFontErr is like [OpenFont], but returns an error on failure.
+func OpenFontErr(file string, ptsize float32) (*Font, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	font := OpenFont(file, ptsize)
+	if font == nil {
+		return nil, sdl.NewError("TTF_OpenFont")
+	}
+	return font, nil
+}
+
+// OpenFontIOErr is like [OpenFontIO], but returns an error on failure.
+func OpenFontIOErr(src *sdl.IOStream, closeio bool, ptsize float32) (*Font, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	font := OpenFontIO(src, closeio, ptsize)
+	if font == nil {
+		return nil, sdl.NewError("TTF_OpenFontIO")
+	}
+	return font, nil
+}
+
+// SetFontSizeErr is like [SetFontSize], but returns an error on failure.
+func SetFontSizeErr(font *Font, ptsize float32) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return sdl.Check("TTF_SetFontSize", SetFontSize(font, ptsize))
+}
+
+// GetStringSizeErr is like [GetStringSize], but returns the size or an error.
+func GetStringSizeErr(font *Font, text string, length uint64) (w, h int32, err error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	if !GetStringSize(font, text, length, &w, &h) {
+		return 0, 0, sdl.NewError("TTF_GetStringSize")
+	}
+	return w, h, nil
+}
+
+// RenderTextSolidErr is like [RenderTextSolid], but returns an error on failure.
+func RenderTextSolidErr(font *Font, text string, length uint64, fg sdl.Color) (*sdl.Surface, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	surface := RenderTextSolid(font, text, length, fg)
+	if surface == nil {
+		return nil, sdl.NewError("TTF_RenderText_Solid")
+	}
+	return surface, nil
+}
+
+// RenderTextShadedErr is like [RenderTextShaded], but returns an error on failure.
+func RenderTextShadedErr(font *Font, text string, length uint64, fg sdl.Color, bg sdl.Color) (*sdl.Surface, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	surface := RenderTextShaded(font, text, length, fg, bg)
+	if surface == nil {
+		return nil, sdl.NewError("TTF_RenderText_Shaded")
+	}
+	return surface, nil
+}
+
+// RenderTextBlendedErr is like [RenderTextBlended], but returns an error on failure.
+func RenderTextBlendedErr(font *Font, text string, length uint64, fg sdl.Color) (*sdl.Surface, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	surface := RenderTextBlended(font, text, length, fg)
+	if surface == nil {
+		return nil, sdl.NewError("TTF_RenderText_Blended")
+	}
+	return surface, nil
+}
+
+// RenderTextBlendedWrappedErr is like [RenderTextBlendedWrapped], but returns an error on failure.
+func RenderTextBlendedWrappedErr(font *Font, text string, length uint64, fg sdl.Color, wrapWidth int32) (*sdl.Surface, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	surface := RenderTextBlendedWrapped(font, text, length, fg, wrapWidth)
+	if surface == nil {
+		return nil, sdl.NewError("TTF_RenderText_Blended_Wrapped")
+	}
+	return surface, nil
+}
+
+// CreateRendererTextEngineErr is like [CreateRendererTextEngine], but returns an error on failure.
+func CreateRendererTextEngineErr(renderer *sdl.Renderer) (*TextEngine, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	engine := CreateRendererTextEngine(renderer)
+	if engine == nil {
+		return nil, sdl.NewError("TTF_CreateRendererTextEngine")
+	}
+	return engine, nil
+}
+
+// CreateTextErr is like [CreateText], but returns an error on failure.
+func CreateTextErr(engine *TextEngine, font *Font, text string, length uint64) (*Text, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	t := CreateText(engine, font, text, length)
+	if t == nil {
+		return nil, sdl.NewError("TTF_CreateText")
+	}
+	return t, nil
+}
+
+// DrawRendererTextErr is like [DrawRendererText], but returns an error on failure.
+func DrawRendererTextErr(text *Text, x float32, y float32) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return sdl.Check("TTF_DrawRendererText", DrawRendererText(text, x, y))
+}
+
+// SetTextStringErr is like [SetTextString], but returns an error on failure.
+func SetTextStringErr(text *Text, str string, length uint64) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return sdl.Check("TTF_SetTextString", SetTextString(text, str, length))
+}
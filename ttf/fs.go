@@ -0,0 +1,18 @@
+package ttf
+
+import (
+	"io/fs"
+
+	"github.com/jupiterrider/purego-sdl3/sdl"
+)
+
+// OpenFontFS is like [OpenFont], but opens the file name of fsys. See [sdl.IOFromFS].
+//
+// The font reads from the file as long as it is open. [CloseFont] closes the file.
+func OpenFontFS(fsys fs.FS, name string, ptsize float32) (*Font, error) {
+	src, err := sdl.IOFromFS(fsys, name)
+	if err != nil {
+		return nil, err
+	}
+	return OpenFontIOErr(src, true, ptsize)
+}